@@ -0,0 +1,148 @@
+package terminfo
+
+import (
+	"os"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// acsTable lists the line drawing characters of the alternate character
+// set: the VT100 character naming them in acsc, the Unicode character and
+// the ASCII character used by ncurses when the terminal lacks them.
+var acsTable = [...]struct {
+	c     byte
+	r     rune
+	ascii byte
+}{
+	{'+', '→', '>'}, {',', '←', '<'}, {'-', '↑', '^'}, {'.', '↓', 'v'},
+	{'0', '█', '#'}, {'`', '◆', '+'}, {'a', '▒', ':'}, {'f', '°', '\''},
+	{'g', '±', '#'}, {'h', '░', '#'}, {'i', '␋', '#'}, {'j', '┘', '+'},
+	{'k', '┐', '+'}, {'l', '┌', '+'}, {'m', '└', '+'}, {'n', '┼', '+'},
+	{'o', '⎺', '~'}, {'p', '⎻', '-'}, {'q', '─', '-'}, {'r', '⎼', '-'},
+	{'s', '⎽', '_'}, {'t', '├', '+'}, {'u', '┤', '+'}, {'v', '┴', '+'},
+	{'w', '┬', '+'}, {'x', '│', '|'}, {'y', '≤', '<'}, {'z', '≥', '>'},
+	{'{', 'π', '*'}, {'|', '≠', '!'}, {'}', '£', 'f'}, {'~', '·', 'o'},
+}
+
+// acsIndex maps the Unicode characters of acsTable to their index.
+var acsIndex = make(map[rune]int, len(acsTable))
+
+func init() {
+	for i, e := range acsTable {
+		acsIndex[e.r] = i
+	}
+}
+
+// ACS returns the strings drawing the line drawing characters the terminal
+// has in its alternate character set, keyed by their Unicode characters.
+// Each string switches to the alternate character set with smacs, writes
+// the character given by acsc and switches back with rmacs.
+func (ti *Terminfo) ACS() map[rune]string {
+	m := make(map[rune]string)
+	smacs, rmacs := ti.Strings[caps.EnterAltCharsetMode], ti.Strings[caps.ExitAltCharsetMode]
+	for c, tc := range ti.acsChars() {
+		m[acsTable[acsIndex[c]].r] = smacs + string(tc) + rmacs
+	}
+	return m
+}
+
+// acsChars returns the characters of acsc keyed by the Unicode characters
+// they draw. Characters unknown to acsTable are left out.
+func (ti *Terminfo) acsChars() map[rune]byte {
+	acsc := ti.Strings[caps.AcsChars]
+	m := make(map[rune]byte, len(acsc)/2)
+	for i := 0; i+1 < len(acsc); i += 2 {
+		for _, e := range acsTable {
+			if e.c == acsc[i] {
+				m[e.r] = acsc[i+1]
+				break
+			}
+		}
+	}
+	return m
+}
+
+// ACSChar returns the string drawing the line drawing character r, see
+// ACSString.
+func (ti *Terminfo) ACSChar(r rune) string {
+	return ti.ACSString(string(r))
+}
+
+// ACSString translates the line drawing characters of s, such as '┌' and
+// '─', for the terminal. If the terminal takes UTF-8, see UTF8, s is
+// returned as is. Otherwise runs of the characters are drawn with the
+// alternate character set, and those the terminal lacks are replaced with
+// ASCII characters such as '+' and '-'. The terminal may need ena_acs to be
+// sent once before the alternate character set is used.
+func (ti *Terminfo) ACSString(s string) string {
+	if ti.UTF8() {
+		return s
+	}
+	chars := ti.acsChars()
+	smacs, rmacs := ti.Strings[caps.EnterAltCharsetMode], ti.Strings[caps.ExitAltCharsetMode]
+	var b strings.Builder
+	in := false
+	for _, r := range s {
+		if tc, ok := chars[r]; ok {
+			if !in {
+				b.WriteString(smacs)
+				in = true
+			}
+			b.WriteByte(tc)
+			continue
+		}
+		if in {
+			b.WriteString(rmacs)
+			in = false
+		}
+		if i, ok := acsIndex[r]; ok {
+			b.WriteByte(acsTable[i].ascii)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	if in {
+		b.WriteString(rmacs)
+	}
+	return b.String()
+}
+
+// UTF8 reports whether the terminal takes UTF-8, as given by the U8
+// extended capability or else by the locale in $LC_ALL, $LC_CTYPE or $LANG.
+func (ti *Terminfo) UTF8() bool {
+	if n, ok := ti.ExtNumber("U8"); ok {
+		return n > 0
+	}
+	for _, k := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := os.Getenv(k); v != "" {
+			v = strings.ToLower(v)
+			return strings.Contains(v, "utf-8") || strings.Contains(v, "utf8")
+		}
+	}
+	return false
+}
+
+// DrawBox returns the string drawing a box with its upper left corner at
+// row and col, height lines tall and width columns wide, with cursor
+// addressing and the line drawing characters of ACSString. It is empty if
+// the box is smaller than 2 by 2.
+func (ti *Terminfo) DrawBox(row, col, height, width int) string {
+	if height < 2 || width < 2 {
+		return ""
+	}
+	hline := strings.Repeat("─", width-2)
+	var b strings.Builder
+	b.WriteString(ti.Goto(row, col))
+	b.WriteString(ti.ACSString("┌" + hline + "┐"))
+	vline := ti.ACSChar('│')
+	for i := 1; i < height-1; i++ {
+		b.WriteString(ti.Goto(row+i, col))
+		b.WriteString(vline)
+		b.WriteString(ti.Goto(row+i, col+width-1))
+		b.WriteString(vline)
+	}
+	b.WriteString(ti.Goto(row+height-1, col))
+	b.WriteString(ti.ACSString("└" + hline + "┘"))
+	return b.String()
+}
@@ -0,0 +1,41 @@
+package terminfo
+
+import (
+	"os"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestACS(t *testing.T) {
+	ti, err := Load("vt100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti.Set("U8", Capability{Kind: CapNumber, Present: true, Num: 0})
+	if got, want := ti.ACS()['┌'], "\x0el\x0f"; got != want {
+		t.Errorf("ACS()['┌'] = %q, want %q", got, want)
+	}
+	if _, ok := ti.ACS()['→']; ok {
+		t.Error("ACS has an arrow vt100 lacks")
+	}
+	if got, want := ti.ACSString("a┌──┐→b"), "a\x0elqqk\x0f>b"; got != want {
+		t.Errorf("ACSString = %q, want %q", got, want)
+	}
+	ti.Strings[caps.CursorAddress] = "\x1b[%i%p1%d;%p2%dH"
+	want := "\x1b[1;1H\x0elqk\x0f" + "\x1b[2;1H\x0ex\x0f\x1b[2;3H\x0ex\x0f" + "\x1b[3;1H\x0emqj\x0f"
+	if got := ti.DrawBox(0, 0, 3, 3); got != want {
+		t.Errorf("DrawBox = %q, want %q", got, want)
+	}
+
+	ti.Set("U8", Capability{Kind: CapNumber, Present: true, Num: 1})
+	if got := ti.ACSChar('┌'); got != "┌" {
+		t.Errorf("ACSChar with U8 = %q", got)
+	}
+	ti.Set("U8", Capability{Kind: CapNumber})
+	defer os.Setenv("LC_ALL", os.Getenv("LC_ALL"))
+	os.Setenv("LC_ALL", "en_US.UTF-8")
+	if !ti.UTF8() {
+		t.Error("UTF8 ignored the locale")
+	}
+}
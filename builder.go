@@ -0,0 +1,153 @@
+package terminfo
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// These are the errors returned by Builder.Build for bad arguments of
+// earlier calls.
+var (
+	ErrNoName      = errors.New("terminfo: entry without a name")
+	ErrIndexRange  = errors.New("terminfo: capability index out of range")
+	ErrNumberRange = errors.New("terminfo: number out of range of the compiled format")
+)
+
+// BuildError is returned by Builder.Build for entries that Validate finds
+// errors in.
+type BuildError struct {
+	// Problems are the problems of severity SeverityError.
+	Problems []Problem
+}
+
+func (e *BuildError) Error() string {
+	s := "terminfo: invalid entry: " + e.Problems[0].String()
+	if len(e.Problems) > 1 {
+		s += " (and more)"
+	}
+	return s
+}
+
+// Builder constructs entries in Go code, for tests and for systems without
+// a terminfo database. Its methods return the Builder so that calls can be
+// chained, and the first bad argument is reported by Build:
+//
+//	ti, err := terminfo.NewBuilder("myterm", "my terminal").
+//		SetNumber(caps.Columns, 80).
+//		SetString(caps.CursorAddress, "\x1b[%i%p1%d;%p2%dH").
+//		SetExtBool("Tc", true).
+//		Build()
+type Builder struct {
+	ti  Terminfo
+	err error
+}
+
+// NewBuilder returns a Builder of an entry with the names, the last of
+// several being the description as in compiled entries.
+func NewBuilder(names ...string) *Builder {
+	b := new(Builder)
+	b.ti.Names = append([]string(nil), names...)
+	return b
+}
+
+// fail records err unless an error was recorded before.
+func (b *Builder) fail(err error) *Builder {
+	if b.err == nil {
+		b.err = err
+	}
+	return b
+}
+
+// SetBool sets the standard boolean capability at i, such as caps.AutoRightMargin.
+func (b *Builder) SetBool(i int, v bool) *Builder {
+	if i < 0 || i >= caps.BoolCount {
+		return b.fail(ErrIndexRange)
+	}
+	b.ti.Bools[i] = v
+	return b
+}
+
+// SetNumber sets the standard number capability at i, such as caps.Columns.
+func (b *Builder) SetNumber(i, n int) *Builder {
+	if i < 0 || i >= caps.NumberCount {
+		return b.fail(ErrIndexRange)
+	}
+	return b.set(caps.NumberNames[i], Capability{Kind: CapNumber, Present: true, Num: n})
+}
+
+// SetString sets the standard string capability at i, such as
+// caps.CursorAddress.
+func (b *Builder) SetString(i int, s string) *Builder {
+	if i < 0 || i >= caps.StringCount {
+		return b.fail(ErrIndexRange)
+	}
+	return b.set(caps.StringNames[i], Capability{Kind: CapString, Present: true, Str: s})
+}
+
+// SetExtBool sets the extended boolean capability with the name.
+func (b *Builder) SetExtBool(name string, v bool) *Builder {
+	return b.setExt(name, Capability{Kind: CapBool, Present: true, Bool: v})
+}
+
+// SetExtNumber sets the extended number capability with the name.
+func (b *Builder) SetExtNumber(name string, n int) *Builder {
+	return b.setExt(name, Capability{Kind: CapNumber, Present: true, Num: n})
+}
+
+// SetExtString sets the extended string capability with the name.
+func (b *Builder) SetExtString(name, s string) *Builder {
+	return b.setExt(name, Capability{Kind: CapString, Present: true, Str: s})
+}
+
+// Use fills the capabilities not set yet from ti, like use= in the source
+// format.
+func (b *Builder) Use(ti *Terminfo) *Builder {
+	b.ti.Use(ti)
+	return b
+}
+
+// setExt sets an extended capability, rejecting names that are standard or
+// that cannot be written in the source format.
+func (b *Builder) setExt(name string, c Capability) *Builder {
+	if name == "" || standardName(name) || strings.ContainsAny(name, ",=#@|\\^ \t\n") {
+		return b.fail(ErrBadCapName)
+	}
+	return b.set(name, c)
+}
+
+// set sets the capability, rejecting numbers the compiled format cannot hold.
+func (b *Builder) set(name string, c Capability) *Builder {
+	if c.Kind == CapNumber && (c.Num < 0 || c.Num > math.MaxInt16) {
+		return b.fail(ErrNumberRange)
+	}
+	if err := b.ti.Set(name, c); err != nil {
+		return b.fail(err)
+	}
+	return b
+}
+
+// Build returns the entry. It returns the first error caused by the
+// arguments of earlier calls, or a *BuildError if Validate finds errors
+// in the entry. Warnings are ignored. The Builder may be used further,
+// the entry returned being a copy.
+func (b *Builder) Build() (*Terminfo, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.ti.Names) == 0 || b.ti.Names[0] == "" {
+		return nil, ErrNoName
+	}
+	var errs []Problem
+	for _, p := range Validate(&b.ti) {
+		if p.Severity == SeverityError {
+			errs = append(errs, p)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, &BuildError{Problems: errs}
+	}
+	return b.ti.Clone(), nil
+}
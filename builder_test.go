@@ -0,0 +1,47 @@
+package terminfo
+
+import (
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder("myterm", "my terminal").
+		SetBool(caps.AutoRightMargin, true).
+		SetNumber(caps.Columns, 80).
+		SetString(caps.CursorAddress, "\x1b[%i%p1%d;%p2%dH").
+		SetExtBool("Tc", true)
+	ti, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Names[0] != "myterm" || !ti.Bools[caps.AutoRightMargin] || ti.Numbers[caps.Columns] != 80 ||
+		ti.Strings[caps.CursorAddress] == "" || !ti.ExtBool("Tc") {
+		t.Errorf("built %+v", ti)
+	}
+	ti.Numbers[caps.Columns] = 132
+	if ti2, _ := b.Build(); ti2.Numbers[caps.Columns] != 80 {
+		t.Error("the built entry shares memory with the builder")
+	}
+
+	tests := []struct {
+		b   *Builder
+		err error
+	}{
+		{NewBuilder(), ErrNoName},
+		{NewBuilder("t").SetString(caps.StringCount, "x"), ErrIndexRange},
+		{NewBuilder("t").SetNumber(caps.Columns, 1<<16), ErrNumberRange},
+		{NewBuilder("t").SetExtString("cup", "x").SetNumber(-1, 0), ErrBadCapName},
+	}
+	for i, tt := range tests {
+		if _, err := tt.b.Build(); err != tt.err {
+			t.Errorf("%d: got %v, want %v", i, err, tt.err)
+		}
+	}
+
+	_, err = NewBuilder("t").SetString(caps.CursorAddress, "%p1%").Build()
+	if be, ok := err.(*BuildError); !ok || be.Problems[0].Cap != "cup" {
+		t.Errorf("got %v, want a *BuildError for cup", err)
+	}
+}
@@ -0,0 +1,100 @@
+package terminfo
+
+import (
+	"strings"
+	"sync"
+)
+
+// Builtin terminfo entries.
+var (
+	builtinMutex sync.RWMutex
+	builtin      = make(map[string]*Terminfo)
+)
+
+// RegisterBuiltin registers ti under each of its names so that
+// LoadWithFallback can return it when the terminfo database does not
+// contain the entry. Registering a name twice replaces the previous entry.
+func RegisterBuiltin(ti *Terminfo) {
+	builtinMutex.Lock()
+	for _, n := range ti.Names {
+		builtin[n] = ti
+	}
+	builtinMutex.Unlock()
+}
+
+// LoadWithFallback calls Load and, if it fails, returns a copy of the builtin
+// entry registered for the name instead. The error from Load is returned if no such
+// entry exists.
+func LoadWithFallback(name string) (*Terminfo, error) {
+	ti, err := Load(name)
+	if err == nil {
+		return ti, nil
+	}
+	builtinMutex.RLock()
+	bti, ok := builtin[name]
+	builtinMutex.RUnlock()
+	if !ok {
+		return nil, err
+	}
+	return bti.Clone(), nil
+}
+
+// termAliases maps terminals that are often missing from older databases to
+// a close entry that is usually present.
+var termAliases = map[string]string{
+	"tmux":          "screen",
+	"xterm-kitty":   "xterm-256color",
+	"xterm-ghostty": "xterm-256color",
+	"alacritty":     "xterm-256color",
+	"foot":          "xterm-256color",
+	"wezterm":       "xterm-256color",
+}
+
+// LoadWithFallbacks calls LoadWithFallback with the name and, if it fails,
+// with progressively more generic names derived from it, such as
+// "screen-256color" and "screen" for "tmux-256color-direct", and then with
+// each of the fallbacks in order. The first entry found is returned, its
+// Names tell which one it is. The error for the name is returned if none is
+// found.
+func LoadWithFallbacks(name string, fallbacks ...string) (*Terminfo, error) {
+	ti, err := LoadWithFallback(name)
+	if err == nil {
+		return ti, nil
+	}
+	for _, n := range append(degrade(name), fallbacks...) {
+		if fti, ferr := LoadWithFallback(n); ferr == nil {
+			return fti, nil
+		}
+	}
+	return nil, err
+}
+
+// degrade returns the names to try in order when the named entry is missing.
+// Each suffix such as "-direct" or "-256color" is stripped in turn and
+// known aliases are substituted.
+func degrade(name string) []string {
+	var names []string
+	seen := map[string]bool{name: true}
+	add := func(n string) {
+		if !seen[n] {
+			seen[n] = true
+			names = append(names, n)
+		}
+	}
+	for n := name; ; {
+		if a, ok := termAliases[n]; ok {
+			add(a)
+		}
+		if i := strings.IndexByte(n, '-'); i > 0 {
+			if a, ok := termAliases[n[:i]]; ok {
+				add(a + n[i:])
+			}
+		}
+		i := strings.LastIndexByte(n, '-')
+		if i <= 0 {
+			return names
+		}
+		n = n[:i]
+		add(n)
+	}
+}
@@ -0,0 +1,13 @@
+// Package builtin registers a small set of common terminal descriptions
+// (xterm, xterm-256color, screen, tmux, linux, vt100 and rxvt) with the
+// terminfo package, for use in environments without a terminfo database.
+//
+// It is used for its side effects:
+//
+//	import _ "github.com/nhooyr/terminfo/builtin"
+//
+// After which terminfo.LoadWithFallback will return the builtin entries when
+// they cannot be found on the system.
+package builtin
+
+//go:generate go run mkbuiltin.go -o entries.go
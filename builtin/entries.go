@@ -0,0 +1,1394 @@
+// Code generated by mkbuiltin.go; DO NOT EDIT.
+
+package builtin
+
+import (
+	"github.com/nhooyr/terminfo"
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func init() {
+	terminfo.RegisterBuiltin(&terminfo.Terminfo{
+		Names: []string{"xterm", "xterm terminal emulator (X Window System)"},
+		Bools: [caps.BoolCount]bool{
+			caps.AutoRightMargin:  true,
+			caps.EatNewlineGlitch: true,
+			caps.HasMetaKey:       true,
+			caps.MoveInsertMode:   true,
+			caps.MoveStandoutMode: true,
+			caps.PrtrSilent:       true,
+			caps.NoPadChar:        true,
+			caps.BackColorErase:   true,
+			caps.BackspacesWithBs: true,
+		},
+		Numbers: [caps.NumberCount]int16{
+			caps.Columns:   80,
+			caps.InitTabs:  8,
+			caps.Lines:     24,
+			caps.MaxColors: 8,
+			caps.MaxPairs:  64,
+		},
+		Strings: [caps.StringCount]string{
+			caps.BackTab:             "\x1b[Z",
+			caps.Bell:                "\a",
+			caps.CarriageReturn:      "\r",
+			caps.ChangeScrollRegion:  "\x1b[%i%p1%d;%p2%dr",
+			caps.ClearAllTabs:        "\x1b[3g",
+			caps.ClearScreen:         "\x1b[H\x1b[2J",
+			caps.ClrEol:              "\x1b[K",
+			caps.ClrEos:              "\x1b[J",
+			caps.ColumnAddress:       "\x1b[%i%p1%dG",
+			caps.CursorAddress:       "\x1b[%i%p1%d;%p2%dH",
+			caps.CursorDown:          "\n",
+			caps.CursorHome:          "\x1b[H",
+			caps.CursorInvisible:     "\x1b[?25l",
+			caps.CursorLeft:          "\b",
+			caps.CursorNormal:        "\x1b[?12l\x1b[?25h",
+			caps.CursorRight:         "\x1b[C",
+			caps.CursorUp:            "\x1b[A",
+			caps.CursorVisible:       "\x1b[?12;25h",
+			caps.DeleteCharacter:     "\x1b[P",
+			caps.DeleteLine:          "\x1b[M",
+			caps.EnterAltCharsetMode: "\x1b(0",
+			caps.EnterBlinkMode:      "\x1b[5m",
+			caps.EnterBoldMode:       "\x1b[1m",
+			caps.EnterCaMode:         "\x1b[?1049h\x1b[22;0;0t",
+			caps.EnterDimMode:        "\x1b[2m",
+			caps.EnterInsertMode:     "\x1b[4h",
+			caps.EnterSecureMode:     "\x1b[8m",
+			caps.EnterReverseMode:    "\x1b[7m",
+			caps.EnterStandoutMode:   "\x1b[7m",
+			caps.EnterUnderlineMode:  "\x1b[4m",
+			caps.EraseChars:          "\x1b[%p1%dX",
+			caps.ExitAltCharsetMode:  "\x1b(B",
+			caps.ExitAttributeMode:   "\x1b(B\x1b[m",
+			caps.ExitCaMode:          "\x1b[?1049l\x1b[23;0;0t",
+			caps.ExitInsertMode:      "\x1b[4l",
+			caps.ExitStandoutMode:    "\x1b[27m",
+			caps.ExitUnderlineMode:   "\x1b[24m",
+			caps.FlashScreen:         "\x1b[?5h$<100/>\x1b[?5l",
+			caps.Init2string:         "\x1b[!p\x1b[?3;4l\x1b[4l\x1b>",
+			caps.InsertLine:          "\x1b[L",
+			caps.KeyBackspace:        "\x7f",
+			caps.KeyDc:               "\x1b[3~",
+			caps.KeyDown:             "\x1bOB",
+			caps.KeyF1:               "\x1bOP",
+			caps.KeyF10:              "\x1b[21~",
+			caps.KeyF2:               "\x1bOQ",
+			caps.KeyF3:               "\x1bOR",
+			caps.KeyF4:               "\x1bOS",
+			caps.KeyF5:               "\x1b[15~",
+			caps.KeyF6:               "\x1b[17~",
+			caps.KeyF7:               "\x1b[18~",
+			caps.KeyF8:               "\x1b[19~",
+			caps.KeyF9:               "\x1b[20~",
+			caps.KeyHome:             "\x1bOH",
+			caps.KeyIc:               "\x1b[2~",
+			caps.KeyLeft:             "\x1bOD",
+			caps.KeyNpage:            "\x1b[6~",
+			caps.KeyPpage:            "\x1b[5~",
+			caps.KeyRight:            "\x1bOC",
+			caps.KeySf:               "\x1b[1;2B",
+			caps.KeySr:               "\x1b[1;2A",
+			caps.KeyUp:               "\x1bOA",
+			caps.KeypadLocal:         "\x1b[?1l\x1b>",
+			caps.KeypadXmit:          "\x1b[?1h\x1b=",
+			caps.MetaOff:             "\x1b[?1034l",
+			caps.MetaOn:              "\x1b[?1034h",
+			caps.Newline:             "\x1bE",
+			caps.ParmDch:             "\x1b[%p1%dP",
+			caps.ParmDeleteLine:      "\x1b[%p1%dM",
+			caps.ParmDownCursor:      "\x1b[%p1%dB",
+			caps.ParmIch:             "\x1b[%p1%d@",
+			caps.ParmIndex:           "\x1b[%p1%dS",
+			caps.ParmInsertLine:      "\x1b[%p1%dL",
+			caps.ParmLeftCursor:      "\x1b[%p1%dD",
+			caps.ParmRightCursor:     "\x1b[%p1%dC",
+			caps.ParmRindex:          "\x1b[%p1%dT",
+			caps.ParmUpCursor:        "\x1b[%p1%dA",
+			caps.PrintScreen:         "\x1b[i",
+			caps.PrtrOff:             "\x1b[4i",
+			caps.PrtrOn:              "\x1b[5i",
+			caps.RepeatChar:          "%p1%c\x1b[%p2%{1}%-%db",
+			caps.Reset1string:        "\x1bc",
+			caps.Reset2string:        "\x1b[!p\x1b[?3;4l\x1b[4l\x1b>",
+			caps.RestoreCursor:       "\x1b8",
+			caps.RowAddress:          "\x1b[%i%p1%dd",
+			caps.SaveCursor:          "\x1b7",
+			caps.ScrollForward:       "\n",
+			caps.ScrollReverse:       "\x1bM",
+			caps.SetAttributes:       "%?%p9%t\x1b(0%e\x1b(B%;\x1b[0%?%p6%t;1%;%?%p5%t;2%;%?%p2%t;4%;%?%p1%p3%|%t;7%;%?%p4%t;5%;%?%p7%t;8%;m",
+			caps.SetTab:              "\x1bH",
+			caps.Tab:                 "\t",
+			caps.KeyA1:               "\x1bOw",
+			caps.KeyA3:               "\x1bOy",
+			caps.KeyB2:               "\x1bOu",
+			caps.KeyC1:               "\x1bOq",
+			caps.KeyC3:               "\x1bOs",
+			caps.AcsChars:            "``aaffggiijjkkllmmnnooppqqrrssttuuvvwwxxyyzz{{||}}~~",
+			caps.KeyBtab:             "\x1b[Z",
+			caps.EnterAmMode:         "\x1b[?7h",
+			caps.ExitAmMode:          "\x1b[?7l",
+			caps.KeyBeg:              "\x1bOE",
+			caps.KeyEnd:              "\x1bOF",
+			caps.KeyEnter:            "\x1bOM",
+			caps.KeySdc:              "\x1b[3;2~",
+			caps.KeySend:             "\x1b[1;2F",
+			caps.KeyShome:            "\x1b[1;2H",
+			caps.KeySic:              "\x1b[2;2~",
+			caps.KeySleft:            "\x1b[1;2D",
+			caps.KeySnext:            "\x1b[6;2~",
+			caps.KeySprevious:        "\x1b[5;2~",
+			caps.KeySright:           "\x1b[1;2C",
+			caps.KeyF11:              "\x1b[23~",
+			caps.KeyF12:              "\x1b[24~",
+			caps.KeyF13:              "\x1b[1;2P",
+			caps.KeyF14:              "\x1b[1;2Q",
+			caps.KeyF15:              "\x1b[1;2R",
+			caps.KeyF16:              "\x1b[1;2S",
+			caps.KeyF17:              "\x1b[15;2~",
+			caps.KeyF18:              "\x1b[17;2~",
+			caps.KeyF19:              "\x1b[18;2~",
+			caps.KeyF20:              "\x1b[19;2~",
+			caps.KeyF21:              "\x1b[20;2~",
+			caps.KeyF22:              "\x1b[21;2~",
+			caps.KeyF23:              "\x1b[23;2~",
+			caps.KeyF24:              "\x1b[24;2~",
+			caps.KeyF25:              "\x1b[1;5P",
+			caps.KeyF26:              "\x1b[1;5Q",
+			caps.KeyF27:              "\x1b[1;5R",
+			caps.KeyF28:              "\x1b[1;5S",
+			caps.KeyF29:              "\x1b[15;5~",
+			caps.KeyF30:              "\x1b[17;5~",
+			caps.KeyF31:              "\x1b[18;5~",
+			caps.KeyF32:              "\x1b[19;5~",
+			caps.KeyF33:              "\x1b[20;5~",
+			caps.KeyF34:              "\x1b[21;5~",
+			caps.KeyF35:              "\x1b[23;5~",
+			caps.KeyF36:              "\x1b[24;5~",
+			caps.KeyF37:              "\x1b[1;6P",
+			caps.KeyF38:              "\x1b[1;6Q",
+			caps.KeyF39:              "\x1b[1;6R",
+			caps.KeyF40:              "\x1b[1;6S",
+			caps.KeyF41:              "\x1b[15;6~",
+			caps.KeyF42:              "\x1b[17;6~",
+			caps.KeyF43:              "\x1b[18;6~",
+			caps.KeyF44:              "\x1b[19;6~",
+			caps.KeyF45:              "\x1b[20;6~",
+			caps.KeyF46:              "\x1b[21;6~",
+			caps.KeyF47:              "\x1b[23;6~",
+			caps.KeyF48:              "\x1b[24;6~",
+			caps.KeyF49:              "\x1b[1;3P",
+			caps.KeyF50:              "\x1b[1;3Q",
+			caps.KeyF51:              "\x1b[1;3R",
+			caps.KeyF52:              "\x1b[1;3S",
+			caps.KeyF53:              "\x1b[15;3~",
+			caps.KeyF54:              "\x1b[17;3~",
+			caps.KeyF55:              "\x1b[18;3~",
+			caps.KeyF56:              "\x1b[19;3~",
+			caps.KeyF57:              "\x1b[20;3~",
+			caps.KeyF58:              "\x1b[21;3~",
+			caps.KeyF59:              "\x1b[23;3~",
+			caps.KeyF60:              "\x1b[24;3~",
+			caps.KeyF61:              "\x1b[1;4P",
+			caps.KeyF62:              "\x1b[1;4Q",
+			caps.KeyF63:              "\x1b[1;4R",
+			caps.ClrBol:              "\x1b[1K",
+			caps.ClearMargins:        "\x1b[?69l",
+			caps.User6:               "\x1b[%i%d;%dR",
+			caps.User7:               "\x1b[6n",
+			caps.User8:               "\x1b[?%[;0123456789]c",
+			caps.User9:               "\x1b[c",
+			caps.OrigPair:            "\x1b[39;49m",
+			caps.SetForeground:       "\x1b[3%?%p1%{1}%=%t4%e%p1%{3}%=%t6%e%p1%{4}%=%t1%e%p1%{6}%=%t3%e%p1%d%;m",
+			caps.SetBackground:       "\x1b[4%?%p1%{1}%=%t4%e%p1%{3}%=%t6%e%p1%{4}%=%t1%e%p1%{6}%=%t3%e%p1%d%;m",
+			caps.EnterItalicsMode:    "\x1b[3m",
+			caps.ExitItalicsMode:     "\x1b[23m",
+			caps.SetLeftMarginParm:   "\x1b[?69h\x1b[%i%p1%ds",
+			caps.SetRightMarginParm:  "\x1b[?69h\x1b[%i;%p1%ds",
+			caps.KeyMouse:            "\x1b[<",
+			caps.SetAForeground:      "\x1b[3%p1%dm",
+			caps.SetABackground:      "\x1b[4%p1%dm",
+			caps.SetLrMargin:         "\x1b[?69h\x1b[%i%p1%d;%p2%ds",
+			caps.MemoryLock:          "\x1bl",
+			caps.MemoryUnlock:        "\x1bm",
+		},
+		ExtBools: map[string]bool{
+			"AX": true,
+			"XT": true,
+		},
+		ExtNumbers: map[string]int16{},
+		ExtStrings: map[string]string{
+			"BD":    "\x1b[?2004l",
+			"BE":    "\x1b[?2004h",
+			"Cr":    "\x1b]112\a",
+			"Cs":    "\x1b]12;%p1%s\a",
+			"E3":    "\x1b[3J",
+			"Ms":    "\x1b]52;%p1%s;%p2%s\a",
+			"PE":    "\x1b[201~",
+			"PS":    "\x1b[200~",
+			"Se":    "\x1b[2 q",
+			"Ss":    "\x1b[%p1%d q",
+			"XM":    "\x1b[?1006;1000%?%p1%{1}%=%th%el%;",
+			"kDC3":  "\x1b[3;3~",
+			"kDC4":  "\x1b[3;4~",
+			"kDC5":  "\x1b[3;5~",
+			"kDC6":  "\x1b[3;6~",
+			"kDC7":  "\x1b[3;7~",
+			"kDN":   "\x1b[1;2B",
+			"kDN3":  "\x1b[1;3B",
+			"kDN4":  "\x1b[1;4B",
+			"kDN5":  "\x1b[1;5B",
+			"kDN6":  "\x1b[1;6B",
+			"kDN7":  "\x1b[1;7B",
+			"kEND3": "\x1b[1;3F",
+			"kEND4": "\x1b[1;4F",
+			"kEND5": "\x1b[1;5F",
+			"kEND6": "\x1b[1;6F",
+			"kEND7": "\x1b[1;7F",
+			"kHOM3": "\x1b[1;3H",
+			"kHOM4": "\x1b[1;4H",
+			"kHOM5": "\x1b[1;5H",
+			"kHOM6": "\x1b[1;6H",
+			"kHOM7": "\x1b[1;7H",
+			"kIC3":  "\x1b[2;3~",
+			"kIC4":  "\x1b[2;4~",
+			"kIC5":  "\x1b[2;5~",
+			"kIC6":  "\x1b[2;6~",
+			"kIC7":  "\x1b[2;7~",
+			"kLFT3": "\x1b[1;3D",
+			"kLFT4": "\x1b[1;4D",
+			"kLFT5": "\x1b[1;5D",
+			"kLFT6": "\x1b[1;6D",
+			"kLFT7": "\x1b[1;7D",
+			"kNXT3": "\x1b[6;3~",
+			"kNXT4": "\x1b[6;4~",
+			"kNXT5": "\x1b[6;5~",
+			"kNXT6": "\x1b[6;6~",
+			"kNXT7": "\x1b[6;7~",
+			"kPRV3": "\x1b[5;3~",
+			"kPRV4": "\x1b[5;4~",
+			"kPRV5": "\x1b[5;5~",
+			"kPRV6": "\x1b[5;6~",
+			"kPRV7": "\x1b[5;7~",
+			"kRIT3": "\x1b[1;3C",
+			"kRIT4": "\x1b[1;4C",
+			"kRIT5": "\x1b[1;5C",
+			"kRIT6": "\x1b[1;6C",
+			"kRIT7": "\x1b[1;7C",
+			"kUP":   "\x1b[1;2A",
+			"kUP3":  "\x1b[1;3A",
+			"kUP4":  "\x1b[1;4A",
+			"kUP5":  "\x1b[1;5A",
+			"kUP6":  "\x1b[1;6A",
+			"kUP7":  "\x1b[1;7A",
+			"ka2":   "\x1bOx",
+			"kb1":   "\x1bOt",
+			"kb3":   "\x1bOv",
+			"kc2":   "\x1bOr",
+			"kp5":   "\x1bOE",
+			"kpADD": "\x1bOk",
+			"kpCMA": "\x1bOl",
+			"kpDIV": "\x1bOo",
+			"kpDOT": "\x1bOn",
+			"kpMUL": "\x1bOj",
+			"kpSUB": "\x1bOm",
+			"kpZRO": "\x1bOp",
+			"rmxx":  "\x1b[29m",
+			"smxx":  "\x1b[9m",
+			"xm":    "\x1b[<%i%p3%d;%p1%d;%p2%d;%?%p4%tM%em%;",
+		},
+	})
+	terminfo.RegisterBuiltin(&terminfo.Terminfo{
+		Names: []string{"xterm-256color", "xterm with 256 colors"},
+		Bools: [caps.BoolCount]bool{
+			caps.AutoRightMargin:  true,
+			caps.EatNewlineGlitch: true,
+			caps.HasMetaKey:       true,
+			caps.MoveInsertMode:   true,
+			caps.MoveStandoutMode: true,
+			caps.PrtrSilent:       true,
+			caps.NoPadChar:        true,
+			caps.CanChange:        true,
+			caps.BackColorErase:   true,
+			caps.BackspacesWithBs: true,
+		},
+		Numbers: [caps.NumberCount]int16{
+			caps.Columns:   80,
+			caps.InitTabs:  8,
+			caps.Lines:     24,
+			caps.MaxColors: 256,
+			caps.MaxPairs:  32767,
+		},
+		Strings: [caps.StringCount]string{
+			caps.BackTab:             "\x1b[Z",
+			caps.Bell:                "\a",
+			caps.CarriageReturn:      "\r",
+			caps.ChangeScrollRegion:  "\x1b[%i%p1%d;%p2%dr",
+			caps.ClearAllTabs:        "\x1b[3g",
+			caps.ClearScreen:         "\x1b[H\x1b[2J",
+			caps.ClrEol:              "\x1b[K",
+			caps.ClrEos:              "\x1b[J",
+			caps.ColumnAddress:       "\x1b[%i%p1%dG",
+			caps.CursorAddress:       "\x1b[%i%p1%d;%p2%dH",
+			caps.CursorDown:          "\n",
+			caps.CursorHome:          "\x1b[H",
+			caps.CursorInvisible:     "\x1b[?25l",
+			caps.CursorLeft:          "\b",
+			caps.CursorNormal:        "\x1b[?12l\x1b[?25h",
+			caps.CursorRight:         "\x1b[C",
+			caps.CursorUp:            "\x1b[A",
+			caps.CursorVisible:       "\x1b[?12;25h",
+			caps.DeleteCharacter:     "\x1b[P",
+			caps.DeleteLine:          "\x1b[M",
+			caps.EnterAltCharsetMode: "\x1b(0",
+			caps.EnterBlinkMode:      "\x1b[5m",
+			caps.EnterBoldMode:       "\x1b[1m",
+			caps.EnterCaMode:         "\x1b[?1049h\x1b[22;0;0t",
+			caps.EnterDimMode:        "\x1b[2m",
+			caps.EnterInsertMode:     "\x1b[4h",
+			caps.EnterSecureMode:     "\x1b[8m",
+			caps.EnterReverseMode:    "\x1b[7m",
+			caps.EnterStandoutMode:   "\x1b[7m",
+			caps.EnterUnderlineMode:  "\x1b[4m",
+			caps.EraseChars:          "\x1b[%p1%dX",
+			caps.ExitAltCharsetMode:  "\x1b(B",
+			caps.ExitAttributeMode:   "\x1b(B\x1b[m",
+			caps.ExitCaMode:          "\x1b[?1049l\x1b[23;0;0t",
+			caps.ExitInsertMode:      "\x1b[4l",
+			caps.ExitStandoutMode:    "\x1b[27m",
+			caps.ExitUnderlineMode:   "\x1b[24m",
+			caps.FlashScreen:         "\x1b[?5h$<100/>\x1b[?5l",
+			caps.Init2string:         "\x1b[!p\x1b[?3;4l\x1b[4l\x1b>",
+			caps.InsertLine:          "\x1b[L",
+			caps.KeyBackspace:        "\x7f",
+			caps.KeyDc:               "\x1b[3~",
+			caps.KeyDown:             "\x1bOB",
+			caps.KeyF1:               "\x1bOP",
+			caps.KeyF10:              "\x1b[21~",
+			caps.KeyF2:               "\x1bOQ",
+			caps.KeyF3:               "\x1bOR",
+			caps.KeyF4:               "\x1bOS",
+			caps.KeyF5:               "\x1b[15~",
+			caps.KeyF6:               "\x1b[17~",
+			caps.KeyF7:               "\x1b[18~",
+			caps.KeyF8:               "\x1b[19~",
+			caps.KeyF9:               "\x1b[20~",
+			caps.KeyHome:             "\x1bOH",
+			caps.KeyIc:               "\x1b[2~",
+			caps.KeyLeft:             "\x1bOD",
+			caps.KeyNpage:            "\x1b[6~",
+			caps.KeyPpage:            "\x1b[5~",
+			caps.KeyRight:            "\x1bOC",
+			caps.KeySf:               "\x1b[1;2B",
+			caps.KeySr:               "\x1b[1;2A",
+			caps.KeyUp:               "\x1bOA",
+			caps.KeypadLocal:         "\x1b[?1l\x1b>",
+			caps.KeypadXmit:          "\x1b[?1h\x1b=",
+			caps.MetaOff:             "\x1b[?1034l",
+			caps.MetaOn:              "\x1b[?1034h",
+			caps.Newline:             "\x1bE",
+			caps.ParmDch:             "\x1b[%p1%dP",
+			caps.ParmDeleteLine:      "\x1b[%p1%dM",
+			caps.ParmDownCursor:      "\x1b[%p1%dB",
+			caps.ParmIch:             "\x1b[%p1%d@",
+			caps.ParmIndex:           "\x1b[%p1%dS",
+			caps.ParmInsertLine:      "\x1b[%p1%dL",
+			caps.ParmLeftCursor:      "\x1b[%p1%dD",
+			caps.ParmRightCursor:     "\x1b[%p1%dC",
+			caps.ParmRindex:          "\x1b[%p1%dT",
+			caps.ParmUpCursor:        "\x1b[%p1%dA",
+			caps.PrintScreen:         "\x1b[i",
+			caps.PrtrOff:             "\x1b[4i",
+			caps.PrtrOn:              "\x1b[5i",
+			caps.RepeatChar:          "%p1%c\x1b[%p2%{1}%-%db",
+			caps.Reset1string:        "\x1bc\x1b]104\a",
+			caps.Reset2string:        "\x1b[!p\x1b[?3;4l\x1b[4l\x1b>",
+			caps.RestoreCursor:       "\x1b8",
+			caps.RowAddress:          "\x1b[%i%p1%dd",
+			caps.SaveCursor:          "\x1b7",
+			caps.ScrollForward:       "\n",
+			caps.ScrollReverse:       "\x1bM",
+			caps.SetAttributes:       "%?%p9%t\x1b(0%e\x1b(B%;\x1b[0%?%p6%t;1%;%?%p5%t;2%;%?%p2%t;4%;%?%p1%p3%|%t;7%;%?%p4%t;5%;%?%p7%t;8%;m",
+			caps.SetTab:              "\x1bH",
+			caps.Tab:                 "\t",
+			caps.KeyA1:               "\x1bOw",
+			caps.KeyA3:               "\x1bOy",
+			caps.KeyB2:               "\x1bOu",
+			caps.KeyC1:               "\x1bOq",
+			caps.KeyC3:               "\x1bOs",
+			caps.AcsChars:            "``aaffggiijjkkllmmnnooppqqrrssttuuvvwwxxyyzz{{||}}~~",
+			caps.KeyBtab:             "\x1b[Z",
+			caps.EnterAmMode:         "\x1b[?7h",
+			caps.ExitAmMode:          "\x1b[?7l",
+			caps.KeyBeg:              "\x1bOE",
+			caps.KeyEnd:              "\x1bOF",
+			caps.KeyEnter:            "\x1bOM",
+			caps.KeySdc:              "\x1b[3;2~",
+			caps.KeySend:             "\x1b[1;2F",
+			caps.KeyShome:            "\x1b[1;2H",
+			caps.KeySic:              "\x1b[2;2~",
+			caps.KeySleft:            "\x1b[1;2D",
+			caps.KeySnext:            "\x1b[6;2~",
+			caps.KeySprevious:        "\x1b[5;2~",
+			caps.KeySright:           "\x1b[1;2C",
+			caps.KeyF11:              "\x1b[23~",
+			caps.KeyF12:              "\x1b[24~",
+			caps.KeyF13:              "\x1b[1;2P",
+			caps.KeyF14:              "\x1b[1;2Q",
+			caps.KeyF15:              "\x1b[1;2R",
+			caps.KeyF16:              "\x1b[1;2S",
+			caps.KeyF17:              "\x1b[15;2~",
+			caps.KeyF18:              "\x1b[17;2~",
+			caps.KeyF19:              "\x1b[18;2~",
+			caps.KeyF20:              "\x1b[19;2~",
+			caps.KeyF21:              "\x1b[20;2~",
+			caps.KeyF22:              "\x1b[21;2~",
+			caps.KeyF23:              "\x1b[23;2~",
+			caps.KeyF24:              "\x1b[24;2~",
+			caps.KeyF25:              "\x1b[1;5P",
+			caps.KeyF26:              "\x1b[1;5Q",
+			caps.KeyF27:              "\x1b[1;5R",
+			caps.KeyF28:              "\x1b[1;5S",
+			caps.KeyF29:              "\x1b[15;5~",
+			caps.KeyF30:              "\x1b[17;5~",
+			caps.KeyF31:              "\x1b[18;5~",
+			caps.KeyF32:              "\x1b[19;5~",
+			caps.KeyF33:              "\x1b[20;5~",
+			caps.KeyF34:              "\x1b[21;5~",
+			caps.KeyF35:              "\x1b[23;5~",
+			caps.KeyF36:              "\x1b[24;5~",
+			caps.KeyF37:              "\x1b[1;6P",
+			caps.KeyF38:              "\x1b[1;6Q",
+			caps.KeyF39:              "\x1b[1;6R",
+			caps.KeyF40:              "\x1b[1;6S",
+			caps.KeyF41:              "\x1b[15;6~",
+			caps.KeyF42:              "\x1b[17;6~",
+			caps.KeyF43:              "\x1b[18;6~",
+			caps.KeyF44:              "\x1b[19;6~",
+			caps.KeyF45:              "\x1b[20;6~",
+			caps.KeyF46:              "\x1b[21;6~",
+			caps.KeyF47:              "\x1b[23;6~",
+			caps.KeyF48:              "\x1b[24;6~",
+			caps.KeyF49:              "\x1b[1;3P",
+			caps.KeyF50:              "\x1b[1;3Q",
+			caps.KeyF51:              "\x1b[1;3R",
+			caps.KeyF52:              "\x1b[1;3S",
+			caps.KeyF53:              "\x1b[15;3~",
+			caps.KeyF54:              "\x1b[17;3~",
+			caps.KeyF55:              "\x1b[18;3~",
+			caps.KeyF56:              "\x1b[19;3~",
+			caps.KeyF57:              "\x1b[20;3~",
+			caps.KeyF58:              "\x1b[21;3~",
+			caps.KeyF59:              "\x1b[23;3~",
+			caps.KeyF60:              "\x1b[24;3~",
+			caps.KeyF61:              "\x1b[1;4P",
+			caps.KeyF62:              "\x1b[1;4Q",
+			caps.KeyF63:              "\x1b[1;4R",
+			caps.ClrBol:              "\x1b[1K",
+			caps.ClearMargins:        "\x1b[?69l",
+			caps.User6:               "\x1b[%i%d;%dR",
+			caps.User7:               "\x1b[6n",
+			caps.User8:               "\x1b[?%[;0123456789]c",
+			caps.User9:               "\x1b[c",
+			caps.OrigPair:            "\x1b[39;49m",
+			caps.OrigColors:          "\x1b]104\a",
+			caps.InitializeColor:     "\x1b]4;%p1%d;rgb:%p2%{255}%*%{1000}%/%2.2X/%p3%{255}%*%{1000}%/%2.2X/%p4%{255}%*%{1000}%/%2.2X\x1b\\",
+			caps.EnterItalicsMode:    "\x1b[3m",
+			caps.ExitItalicsMode:     "\x1b[23m",
+			caps.SetLeftMarginParm:   "\x1b[?69h\x1b[%i%p1%ds",
+			caps.SetRightMarginParm:  "\x1b[?69h\x1b[%i;%p1%ds",
+			caps.KeyMouse:            "\x1b[<",
+			caps.SetAForeground:      "\x1b[%?%p1%{8}%<%t3%p1%d%e%p1%{16}%<%t9%p1%{8}%-%d%e38;5;%p1%d%;m",
+			caps.SetABackground:      "\x1b[%?%p1%{8}%<%t4%p1%d%e%p1%{16}%<%t10%p1%{8}%-%d%e48;5;%p1%d%;m",
+			caps.SetLrMargin:         "\x1b[?69h\x1b[%i%p1%d;%p2%ds",
+			caps.MemoryLock:          "\x1bl",
+			caps.MemoryUnlock:        "\x1bm",
+		},
+		ExtBools: map[string]bool{
+			"AX": true,
+			"XT": true,
+		},
+		ExtNumbers: map[string]int16{},
+		ExtStrings: map[string]string{
+			"BD":    "\x1b[?2004l",
+			"BE":    "\x1b[?2004h",
+			"Cr":    "\x1b]112\a",
+			"Cs":    "\x1b]12;%p1%s\a",
+			"E3":    "\x1b[3J",
+			"Ms":    "\x1b]52;%p1%s;%p2%s\a",
+			"PE":    "\x1b[201~",
+			"PS":    "\x1b[200~",
+			"Se":    "\x1b[2 q",
+			"Ss":    "\x1b[%p1%d q",
+			"XM":    "\x1b[?1006;1000%?%p1%{1}%=%th%el%;",
+			"kDC3":  "\x1b[3;3~",
+			"kDC4":  "\x1b[3;4~",
+			"kDC5":  "\x1b[3;5~",
+			"kDC6":  "\x1b[3;6~",
+			"kDC7":  "\x1b[3;7~",
+			"kDN":   "\x1b[1;2B",
+			"kDN3":  "\x1b[1;3B",
+			"kDN4":  "\x1b[1;4B",
+			"kDN5":  "\x1b[1;5B",
+			"kDN6":  "\x1b[1;6B",
+			"kDN7":  "\x1b[1;7B",
+			"kEND3": "\x1b[1;3F",
+			"kEND4": "\x1b[1;4F",
+			"kEND5": "\x1b[1;5F",
+			"kEND6": "\x1b[1;6F",
+			"kEND7": "\x1b[1;7F",
+			"kHOM3": "\x1b[1;3H",
+			"kHOM4": "\x1b[1;4H",
+			"kHOM5": "\x1b[1;5H",
+			"kHOM6": "\x1b[1;6H",
+			"kHOM7": "\x1b[1;7H",
+			"kIC3":  "\x1b[2;3~",
+			"kIC4":  "\x1b[2;4~",
+			"kIC5":  "\x1b[2;5~",
+			"kIC6":  "\x1b[2;6~",
+			"kIC7":  "\x1b[2;7~",
+			"kLFT3": "\x1b[1;3D",
+			"kLFT4": "\x1b[1;4D",
+			"kLFT5": "\x1b[1;5D",
+			"kLFT6": "\x1b[1;6D",
+			"kLFT7": "\x1b[1;7D",
+			"kNXT3": "\x1b[6;3~",
+			"kNXT4": "\x1b[6;4~",
+			"kNXT5": "\x1b[6;5~",
+			"kNXT6": "\x1b[6;6~",
+			"kNXT7": "\x1b[6;7~",
+			"kPRV3": "\x1b[5;3~",
+			"kPRV4": "\x1b[5;4~",
+			"kPRV5": "\x1b[5;5~",
+			"kPRV6": "\x1b[5;6~",
+			"kPRV7": "\x1b[5;7~",
+			"kRIT3": "\x1b[1;3C",
+			"kRIT4": "\x1b[1;4C",
+			"kRIT5": "\x1b[1;5C",
+			"kRIT6": "\x1b[1;6C",
+			"kRIT7": "\x1b[1;7C",
+			"kUP":   "\x1b[1;2A",
+			"kUP3":  "\x1b[1;3A",
+			"kUP4":  "\x1b[1;4A",
+			"kUP5":  "\x1b[1;5A",
+			"kUP6":  "\x1b[1;6A",
+			"kUP7":  "\x1b[1;7A",
+			"ka2":   "\x1bOx",
+			"kb1":   "\x1bOt",
+			"kb3":   "\x1bOv",
+			"kc2":   "\x1bOr",
+			"kp5":   "\x1bOE",
+			"kpADD": "\x1bOk",
+			"kpCMA": "\x1bOl",
+			"kpDIV": "\x1bOo",
+			"kpDOT": "\x1bOn",
+			"kpMUL": "\x1bOj",
+			"kpSUB": "\x1bOm",
+			"kpZRO": "\x1bOp",
+			"rmxx":  "\x1b[29m",
+			"smxx":  "\x1b[9m",
+			"xm":    "\x1b[<%i%p3%d;%p1%d;%p2%d;%?%p4%tM%em%;",
+		},
+	})
+	terminfo.RegisterBuiltin(&terminfo.Terminfo{
+		Names: []string{"screen", "VT 100/ANSI X3.64 virtual terminal"},
+		Bools: [caps.BoolCount]bool{
+			caps.AutoRightMargin:  true,
+			caps.EatNewlineGlitch: true,
+			caps.HasMetaKey:       true,
+			caps.MoveInsertMode:   true,
+			caps.MoveStandoutMode: true,
+			caps.BackspacesWithBs: true,
+			caps.HasHardwareTabs:  true,
+		},
+		Numbers: [caps.NumberCount]int16{
+			caps.Columns:   80,
+			caps.InitTabs:  8,
+			caps.Lines:     24,
+			caps.MaxColors: 8,
+			caps.MaxPairs:  64,
+		},
+		Strings: [caps.StringCount]string{
+			caps.BackTab:             "\x1b[Z",
+			caps.Bell:                "\a",
+			caps.CarriageReturn:      "\r",
+			caps.ChangeScrollRegion:  "\x1b[%i%p1%d;%p2%dr",
+			caps.ClearAllTabs:        "\x1b[3g",
+			caps.ClearScreen:         "\x1b[H\x1b[J",
+			caps.ClrEol:              "\x1b[K",
+			caps.ClrEos:              "\x1b[J",
+			caps.ColumnAddress:       "\x1b[%i%p1%dG",
+			caps.CursorAddress:       "\x1b[%i%p1%d;%p2%dH",
+			caps.CursorDown:          "\n",
+			caps.CursorHome:          "\x1b[H",
+			caps.CursorInvisible:     "\x1b[?25l",
+			caps.CursorLeft:          "\b",
+			caps.CursorNormal:        "\x1b[34h\x1b[?25h",
+			caps.CursorRight:         "\x1b[C",
+			caps.CursorUp:            "\x1bM",
+			caps.CursorVisible:       "\x1b[34l",
+			caps.DeleteCharacter:     "\x1b[P",
+			caps.DeleteLine:          "\x1b[M",
+			caps.EnterAltCharsetMode: "\x0e",
+			caps.EnterBlinkMode:      "\x1b[5m",
+			caps.EnterBoldMode:       "\x1b[1m",
+			caps.EnterCaMode:         "\x1b[?1049h",
+			caps.EnterDimMode:        "\x1b[2m",
+			caps.EnterInsertMode:     "\x1b[4h",
+			caps.EnterReverseMode:    "\x1b[7m",
+			caps.EnterStandoutMode:   "\x1b[3m",
+			caps.EnterUnderlineMode:  "\x1b[4m",
+			caps.ExitAltCharsetMode:  "\x0f",
+			caps.ExitAttributeMode:   "\x1b[m\x0f",
+			caps.ExitCaMode:          "\x1b[?1049l",
+			caps.ExitInsertMode:      "\x1b[4l",
+			caps.ExitStandoutMode:    "\x1b[23m",
+			caps.ExitUnderlineMode:   "\x1b[24m",
+			caps.FlashScreen:         "\x1bg",
+			caps.Init2string:         "\x1b)0",
+			caps.InsertLine:          "\x1b[L",
+			caps.KeyBackspace:        "\x7f",
+			caps.KeyDc:               "\x1b[3~",
+			caps.KeyDown:             "\x1bOB",
+			caps.KeyF1:               "\x1bOP",
+			caps.KeyF10:              "\x1b[21~",
+			caps.KeyF2:               "\x1bOQ",
+			caps.KeyF3:               "\x1bOR",
+			caps.KeyF4:               "\x1bOS",
+			caps.KeyF5:               "\x1b[15~",
+			caps.KeyF6:               "\x1b[17~",
+			caps.KeyF7:               "\x1b[18~",
+			caps.KeyF8:               "\x1b[19~",
+			caps.KeyF9:               "\x1b[20~",
+			caps.KeyHome:             "\x1b[1~",
+			caps.KeyIc:               "\x1b[2~",
+			caps.KeyLeft:             "\x1bOD",
+			caps.KeyNpage:            "\x1b[6~",
+			caps.KeyPpage:            "\x1b[5~",
+			caps.KeyRight:            "\x1bOC",
+			caps.KeyUp:               "\x1bOA",
+			caps.KeypadLocal:         "\x1b[?1l\x1b>",
+			caps.KeypadXmit:          "\x1b[?1h\x1b=",
+			caps.Newline:             "\x1bE",
+			caps.ParmDch:             "\x1b[%p1%dP",
+			caps.ParmDeleteLine:      "\x1b[%p1%dM",
+			caps.ParmDownCursor:      "\x1b[%p1%dB",
+			caps.ParmIch:             "\x1b[%p1%d@",
+			caps.ParmIndex:           "\x1b[%p1%dS",
+			caps.ParmInsertLine:      "\x1b[%p1%dL",
+			caps.ParmLeftCursor:      "\x1b[%p1%dD",
+			caps.ParmRightCursor:     "\x1b[%p1%dC",
+			caps.ParmRindex:          "\x1b[%p1%dT",
+			caps.ParmUpCursor:        "\x1b[%p1%dA",
+			caps.Reset2string:        "\x1bc\x1b[?1000l\x1b[?25h",
+			caps.RestoreCursor:       "\x1b8",
+			caps.RowAddress:          "\x1b[%i%p1%dd",
+			caps.SaveCursor:          "\x1b7",
+			caps.ScrollForward:       "\n",
+			caps.ScrollReverse:       "\x1bM",
+			caps.SetAttributes:       "\x1b[0%?%p6%t;1%;%?%p1%t;3%;%?%p2%t;4%;%?%p3%t;7%;%?%p4%t;5%;%?%p5%t;2%;m%?%p9%t\x0e%e\x0f%;",
+			caps.SetTab:              "\x1bH",
+			caps.Tab:                 "\t",
+			caps.AcsChars:            "++,,--..00``aaffgghhiijjkkllmmnnooppqqrrssttuuvvwwxxyyzz{{||}}~~",
+			caps.KeyBtab:             "\x1b[Z",
+			caps.EnaAcs:              "\x1b(B\x1b)0",
+			caps.KeyEnd:              "\x1b[4~",
+			caps.KeyF11:              "\x1b[23~",
+			caps.KeyF12:              "\x1b[24~",
+			caps.ClrBol:              "\x1b[1K",
+			caps.User6:               "\x1b[%i%d;%dR",
+			caps.User7:               "\x1b[6n",
+			caps.User8:               "\x1b[?1;2c",
+			caps.User9:               "\x1b[c",
+			caps.OrigPair:            "\x1b[39;49m",
+			caps.KeyMouse:            "\x1b[M",
+			caps.SetAForeground:      "\x1b[3%p1%dm",
+			caps.SetABackground:      "\x1b[4%p1%dm",
+		},
+		ExtBools: map[string]bool{
+			"AX": true,
+			"G0": true,
+		},
+		ExtNumbers: map[string]int16{
+			"U8": 1,
+		},
+		ExtStrings: map[string]string{
+			"E0": "\x1b(B",
+			"S0": "\x1b(%p1%c",
+		},
+	})
+	terminfo.RegisterBuiltin(&terminfo.Terminfo{
+		Names: []string{"tmux", "tmux terminal multiplexer"},
+		Bools: [caps.BoolCount]bool{
+			caps.AutoRightMargin:  true,
+			caps.EatNewlineGlitch: true,
+			caps.HasMetaKey:       true,
+			caps.HasStatusLine:    true,
+			caps.MoveInsertMode:   true,
+			caps.MoveStandoutMode: true,
+			caps.BackspacesWithBs: true,
+			caps.HasHardwareTabs:  true,
+		},
+		Numbers: [caps.NumberCount]int16{
+			caps.Columns:   80,
+			caps.InitTabs:  8,
+			caps.Lines:     24,
+			caps.MaxColors: 8,
+			caps.MaxPairs:  64,
+		},
+		Strings: [caps.StringCount]string{
+			caps.BackTab:             "\x1b[Z",
+			caps.Bell:                "\a",
+			caps.CarriageReturn:      "\r",
+			caps.ChangeScrollRegion:  "\x1b[%i%p1%d;%p2%dr",
+			caps.ClearAllTabs:        "\x1b[3g",
+			caps.ClearScreen:         "\x1b[H\x1b[J",
+			caps.ClrEol:              "\x1b[K",
+			caps.ClrEos:              "\x1b[J",
+			caps.ColumnAddress:       "\x1b[%i%p1%dG",
+			caps.CursorAddress:       "\x1b[%i%p1%d;%p2%dH",
+			caps.CursorDown:          "\n",
+			caps.CursorHome:          "\x1b[H",
+			caps.CursorInvisible:     "\x1b[?25l",
+			caps.CursorLeft:          "\b",
+			caps.CursorNormal:        "\x1b[34h\x1b[?25h",
+			caps.CursorRight:         "\x1b[C",
+			caps.CursorUp:            "\x1bM",
+			caps.CursorVisible:       "\x1b[34l",
+			caps.DeleteCharacter:     "\x1b[P",
+			caps.DeleteLine:          "\x1b[M",
+			caps.DisStatusLine:       "\x1b]0;\a",
+			caps.EnterAltCharsetMode: "\x0e",
+			caps.EnterBlinkMode:      "\x1b[5m",
+			caps.EnterBoldMode:       "\x1b[1m",
+			caps.EnterCaMode:         "\x1b[?1049h",
+			caps.EnterDimMode:        "\x1b[2m",
+			caps.EnterInsertMode:     "\x1b[4h",
+			caps.EnterSecureMode:     "\x1b[8m",
+			caps.EnterReverseMode:    "\x1b[7m",
+			caps.EnterStandoutMode:   "\x1b[7m",
+			caps.EnterUnderlineMode:  "\x1b[4m",
+			caps.ExitAltCharsetMode:  "\x0f",
+			caps.ExitAttributeMode:   "\x1b[m\x0f",
+			caps.ExitCaMode:          "\x1b[?1049l",
+			caps.ExitInsertMode:      "\x1b[4l",
+			caps.ExitStandoutMode:    "\x1b[27m",
+			caps.ExitUnderlineMode:   "\x1b[24m",
+			caps.FlashScreen:         "\x1bg",
+			caps.FromStatusLine:      "\a",
+			caps.Init2string:         "\x1b)0",
+			caps.InsertLine:          "\x1b[L",
+			caps.KeyBackspace:        "\x7f",
+			caps.KeyDc:               "\x1b[3~",
+			caps.KeyDown:             "\x1bOB",
+			caps.KeyF1:               "\x1bOP",
+			caps.KeyF10:              "\x1b[21~",
+			caps.KeyF2:               "\x1bOQ",
+			caps.KeyF3:               "\x1bOR",
+			caps.KeyF4:               "\x1bOS",
+			caps.KeyF5:               "\x1b[15~",
+			caps.KeyF6:               "\x1b[17~",
+			caps.KeyF7:               "\x1b[18~",
+			caps.KeyF8:               "\x1b[19~",
+			caps.KeyF9:               "\x1b[20~",
+			caps.KeyHome:             "\x1b[1~",
+			caps.KeyIc:               "\x1b[2~",
+			caps.KeyLeft:             "\x1bOD",
+			caps.KeyNpage:            "\x1b[6~",
+			caps.KeyPpage:            "\x1b[5~",
+			caps.KeyRight:            "\x1bOC",
+			caps.KeySf:               "\x1b[1;2B",
+			caps.KeySr:               "\x1b[1;2A",
+			caps.KeyUp:               "\x1bOA",
+			caps.KeypadLocal:         "\x1b[?1l\x1b>",
+			caps.KeypadXmit:          "\x1b[?1h\x1b=",
+			caps.Newline:             "\x1bE",
+			caps.ParmDch:             "\x1b[%p1%dP",
+			caps.ParmDeleteLine:      "\x1b[%p1%dM",
+			caps.ParmDownCursor:      "\x1b[%p1%dB",
+			caps.ParmIch:             "\x1b[%p1%d@",
+			caps.ParmIndex:           "\x1b[%p1%dS",
+			caps.ParmInsertLine:      "\x1b[%p1%dL",
+			caps.ParmLeftCursor:      "\x1b[%p1%dD",
+			caps.ParmRightCursor:     "\x1b[%p1%dC",
+			caps.ParmRindex:          "\x1b[%p1%dT",
+			caps.ParmUpCursor:        "\x1b[%p1%dA",
+			caps.Reset2string:        "\x1bc\x1b[?1000l\x1b[?25h",
+			caps.RestoreCursor:       "\x1b8",
+			caps.RowAddress:          "\x1b[%i%p1%dd",
+			caps.SaveCursor:          "\x1b7",
+			caps.ScrollForward:       "\n",
+			caps.ScrollReverse:       "\x1bM",
+			caps.SetAttributes:       "\x1b[0%?%p6%t;1%;%?%p2%t;4%;%?%p1%p3%|%t;7%;%?%p4%t;5%;%?%p5%t;2%;%?%p7%t;8%;m%?%p9%t\x0e%e\x0f%;",
+			caps.SetTab:              "\x1bH",
+			caps.Tab:                 "\t",
+			caps.ToStatusLine:        "\x1b]0;",
+			caps.AcsChars:            "++,,--..00``aaffgghhiijjkkllmmnnooppqqrrssttuuvvwwxxyyzz{{||}}~~",
+			caps.KeyBtab:             "\x1b[Z",
+			caps.EnaAcs:              "\x1b(B\x1b)0",
+			caps.KeyEnd:              "\x1b[4~",
+			caps.KeySdc:              "\x1b[3;2~",
+			caps.KeySend:             "\x1b[1;2F",
+			caps.KeyShome:            "\x1b[1;2H",
+			caps.KeySic:              "\x1b[2;2~",
+			caps.KeySleft:            "\x1b[1;2D",
+			caps.KeySnext:            "\x1b[6;2~",
+			caps.KeySprevious:        "\x1b[5;2~",
+			caps.KeySright:           "\x1b[1;2C",
+			caps.KeyF11:              "\x1b[23~",
+			caps.KeyF12:              "\x1b[24~",
+			caps.KeyF13:              "\x1b[1;2P",
+			caps.KeyF14:              "\x1b[1;2Q",
+			caps.KeyF15:              "\x1b[1;2R",
+			caps.KeyF16:              "\x1b[1;2S",
+			caps.KeyF17:              "\x1b[15;2~",
+			caps.KeyF18:              "\x1b[17;2~",
+			caps.KeyF19:              "\x1b[18;2~",
+			caps.KeyF20:              "\x1b[19;2~",
+			caps.KeyF21:              "\x1b[20;2~",
+			caps.KeyF22:              "\x1b[21;2~",
+			caps.KeyF23:              "\x1b[23;2~",
+			caps.KeyF24:              "\x1b[24;2~",
+			caps.KeyF25:              "\x1b[1;5P",
+			caps.KeyF26:              "\x1b[1;5Q",
+			caps.KeyF27:              "\x1b[1;5R",
+			caps.KeyF28:              "\x1b[1;5S",
+			caps.KeyF29:              "\x1b[15;5~",
+			caps.KeyF30:              "\x1b[17;5~",
+			caps.KeyF31:              "\x1b[18;5~",
+			caps.KeyF32:              "\x1b[19;5~",
+			caps.KeyF33:              "\x1b[20;5~",
+			caps.KeyF34:              "\x1b[21;5~",
+			caps.KeyF35:              "\x1b[23;5~",
+			caps.KeyF36:              "\x1b[24;5~",
+			caps.KeyF37:              "\x1b[1;6P",
+			caps.KeyF38:              "\x1b[1;6Q",
+			caps.KeyF39:              "\x1b[1;6R",
+			caps.KeyF40:              "\x1b[1;6S",
+			caps.KeyF41:              "\x1b[15;6~",
+			caps.KeyF42:              "\x1b[17;6~",
+			caps.KeyF43:              "\x1b[18;6~",
+			caps.KeyF44:              "\x1b[19;6~",
+			caps.KeyF45:              "\x1b[20;6~",
+			caps.KeyF46:              "\x1b[21;6~",
+			caps.KeyF47:              "\x1b[23;6~",
+			caps.KeyF48:              "\x1b[24;6~",
+			caps.KeyF49:              "\x1b[1;3P",
+			caps.KeyF50:              "\x1b[1;3Q",
+			caps.KeyF51:              "\x1b[1;3R",
+			caps.KeyF52:              "\x1b[1;3S",
+			caps.KeyF53:              "\x1b[15;3~",
+			caps.KeyF54:              "\x1b[17;3~",
+			caps.KeyF55:              "\x1b[18;3~",
+			caps.KeyF56:              "\x1b[19;3~",
+			caps.KeyF57:              "\x1b[20;3~",
+			caps.KeyF58:              "\x1b[21;3~",
+			caps.KeyF59:              "\x1b[23;3~",
+			caps.KeyF60:              "\x1b[24;3~",
+			caps.KeyF61:              "\x1b[1;4P",
+			caps.KeyF62:              "\x1b[1;4Q",
+			caps.KeyF63:              "\x1b[1;4R",
+			caps.ClrBol:              "\x1b[1K",
+			caps.User6:               "\x1b[%i%d;%dR",
+			caps.User7:               "\x1b[6n",
+			caps.User8:               "\x1b[?1;2c",
+			caps.User9:               "\x1b[c",
+			caps.OrigPair:            "\x1b[39;49m",
+			caps.EnterItalicsMode:    "\x1b[3m",
+			caps.ExitItalicsMode:     "\x1b[23m",
+			caps.KeyMouse:            "\x1b[M",
+			caps.SetAForeground:      "\x1b[3%p1%dm",
+			caps.SetABackground:      "\x1b[4%p1%dm",
+		},
+		ExtBools: map[string]bool{
+			"AX": true,
+			"G0": true,
+		},
+		ExtNumbers: map[string]int16{
+			"U8": 1,
+		},
+		ExtStrings: map[string]string{
+			"BD":    "\x1b[?2004l",
+			"BE":    "\x1b[?2004h",
+			"Cr":    "\x1b]112\a",
+			"Cs":    "\x1b]12;%p1%s\a",
+			"E0":    "\x1b(B",
+			"E3":    "\x1b[3J",
+			"Ms":    "\x1b]52;%p1%s;%p2%s\a",
+			"PE":    "\x1b[201~",
+			"PS":    "\x1b[200~",
+			"S0":    "\x1b(%p1%c",
+			"Se":    "\x1b[2 q",
+			"Smulx": "\x1b[4:%p1%dm",
+			"Ss":    "\x1b[%p1%d q",
+			"TS":    "\x1b]0;",
+			"kDC3":  "\x1b[3;3~",
+			"kDC4":  "\x1b[3;4~",
+			"kDC5":  "\x1b[3;5~",
+			"kDC6":  "\x1b[3;6~",
+			"kDC7":  "\x1b[3;7~",
+			"kDN":   "\x1b[1;2B",
+			"kDN3":  "\x1b[1;3B",
+			"kDN4":  "\x1b[1;4B",
+			"kDN5":  "\x1b[1;5B",
+			"kDN6":  "\x1b[1;6B",
+			"kDN7":  "\x1b[1;7B",
+			"kEND3": "\x1b[1;3F",
+			"kEND4": "\x1b[1;4F",
+			"kEND5": "\x1b[1;5F",
+			"kEND6": "\x1b[1;6F",
+			"kEND7": "\x1b[1;7F",
+			"kHOM3": "\x1b[1;3H",
+			"kHOM4": "\x1b[1;4H",
+			"kHOM5": "\x1b[1;5H",
+			"kHOM6": "\x1b[1;6H",
+			"kHOM7": "\x1b[1;7H",
+			"kIC3":  "\x1b[2;3~",
+			"kIC4":  "\x1b[2;4~",
+			"kIC5":  "\x1b[2;5~",
+			"kIC6":  "\x1b[2;6~",
+			"kIC7":  "\x1b[2;7~",
+			"kLFT3": "\x1b[1;3D",
+			"kLFT4": "\x1b[1;4D",
+			"kLFT5": "\x1b[1;5D",
+			"kLFT6": "\x1b[1;6D",
+			"kLFT7": "\x1b[1;7D",
+			"kNXT3": "\x1b[6;3~",
+			"kNXT4": "\x1b[6;4~",
+			"kNXT5": "\x1b[6;5~",
+			"kNXT6": "\x1b[6;6~",
+			"kNXT7": "\x1b[6;7~",
+			"kPRV3": "\x1b[5;3~",
+			"kPRV4": "\x1b[5;4~",
+			"kPRV5": "\x1b[5;5~",
+			"kPRV6": "\x1b[5;6~",
+			"kPRV7": "\x1b[5;7~",
+			"kRIT3": "\x1b[1;3C",
+			"kRIT4": "\x1b[1;4C",
+			"kRIT5": "\x1b[1;5C",
+			"kRIT6": "\x1b[1;6C",
+			"kRIT7": "\x1b[1;7C",
+			"kUP":   "\x1b[1;2A",
+			"kUP3":  "\x1b[1;3A",
+			"kUP4":  "\x1b[1;4A",
+			"kUP5":  "\x1b[1;5A",
+			"kUP6":  "\x1b[1;6A",
+			"kUP7":  "\x1b[1;7A",
+			"rmxx":  "\x1b[29m",
+			"smxx":  "\x1b[9m",
+		},
+	})
+	terminfo.RegisterBuiltin(&terminfo.Terminfo{
+		Names: []string{"linux", "Linux console"},
+		Bools: [caps.BoolCount]bool{
+			caps.AutoRightMargin:  true,
+			caps.EatNewlineGlitch: true,
+			caps.EraseOverstrike:  true,
+			caps.MoveInsertMode:   true,
+			caps.MoveStandoutMode: true,
+			caps.XonXoff:          true,
+			caps.CanChange:        true,
+			caps.BackColorErase:   true,
+		},
+		Numbers: [caps.NumberCount]int16{
+			caps.InitTabs:     8,
+			caps.MaxColors:    8,
+			caps.MaxPairs:     64,
+			caps.NoColorVideo: 18,
+		},
+		Strings: [caps.StringCount]string{
+			caps.Bell:                "\a",
+			caps.CarriageReturn:      "\r",
+			caps.ChangeScrollRegion:  "\x1b[%i%p1%d;%p2%dr",
+			caps.ClearAllTabs:        "\x1b[3g",
+			caps.ClearScreen:         "\x1b[H\x1b[J",
+			caps.ClrEol:              "\x1b[K",
+			caps.ClrEos:              "\x1b[J",
+			caps.ColumnAddress:       "\x1b[%i%p1%dG",
+			caps.CursorAddress:       "\x1b[%i%p1%d;%p2%dH",
+			caps.CursorDown:          "\n",
+			caps.CursorHome:          "\x1b[H",
+			caps.CursorInvisible:     "\x1b[?25l\x1b[?1c",
+			caps.CursorLeft:          "\b",
+			caps.CursorNormal:        "\x1b[?25h\x1b[?0c",
+			caps.CursorRight:         "\x1b[C",
+			caps.CursorUp:            "\x1b[A",
+			caps.CursorVisible:       "\x1b[?25h\x1b[?8c",
+			caps.DeleteCharacter:     "\x1b[P",
+			caps.DeleteLine:          "\x1b[M",
+			caps.EnterAltCharsetMode: "\x0e",
+			caps.EnterBlinkMode:      "\x1b[5m",
+			caps.EnterBoldMode:       "\x1b[1m",
+			caps.EnterDimMode:        "\x1b[2m",
+			caps.EnterInsertMode:     "\x1b[4h",
+			caps.EnterReverseMode:    "\x1b[7m",
+			caps.EnterStandoutMode:   "\x1b[7m",
+			caps.EnterUnderlineMode:  "\x1b[4m",
+			caps.EraseChars:          "\x1b[%p1%dX",
+			caps.ExitAltCharsetMode:  "\x0f",
+			caps.ExitAttributeMode:   "\x1b[m\x0f",
+			caps.ExitInsertMode:      "\x1b[4l",
+			caps.ExitStandoutMode:    "\x1b[27m",
+			caps.ExitUnderlineMode:   "\x1b[24m",
+			caps.FlashScreen:         "\x1b[?5h$<200/>\x1b[?5l",
+			caps.InsertCharacter:     "\x1b[@",
+			caps.InsertLine:          "\x1b[L",
+			caps.KeyBackspace:        "\x7f",
+			caps.KeyDc:               "\x1b[3~",
+			caps.KeyDown:             "\x1b[B",
+			caps.KeyF1:               "\x1b[[A",
+			caps.KeyF10:              "\x1b[21~",
+			caps.KeyF2:               "\x1b[[B",
+			caps.KeyF3:               "\x1b[[C",
+			caps.KeyF4:               "\x1b[[D",
+			caps.KeyF5:               "\x1b[[E",
+			caps.KeyF6:               "\x1b[17~",
+			caps.KeyF7:               "\x1b[18~",
+			caps.KeyF8:               "\x1b[19~",
+			caps.KeyF9:               "\x1b[20~",
+			caps.KeyHome:             "\x1b[1~",
+			caps.KeyIc:               "\x1b[2~",
+			caps.KeyLeft:             "\x1b[D",
+			caps.KeyNpage:            "\x1b[6~",
+			caps.KeyPpage:            "\x1b[5~",
+			caps.KeyRight:            "\x1b[C",
+			caps.KeyUp:               "\x1b[A",
+			caps.Newline:             "\r\n",
+			caps.ParmDch:             "\x1b[%p1%dP",
+			caps.ParmDeleteLine:      "\x1b[%p1%dM",
+			caps.ParmDownCursor:      "\x1b[%p1%dB",
+			caps.ParmIch:             "\x1b[%p1%d@",
+			caps.ParmInsertLine:      "\x1b[%p1%dL",
+			caps.ParmLeftCursor:      "\x1b[%p1%dD",
+			caps.ParmRightCursor:     "\x1b[%p1%dC",
+			caps.ParmUpCursor:        "\x1b[%p1%dA",
+			caps.Reset1string:        "\x1bc\x1b]R",
+			caps.RestoreCursor:       "\x1b8",
+			caps.RowAddress:          "\x1b[%i%p1%dd",
+			caps.SaveCursor:          "\x1b7",
+			caps.ScrollForward:       "\n",
+			caps.ScrollReverse:       "\x1bM",
+			caps.SetAttributes:       "\x1b[0;10%?%p1%t;7%;%?%p2%t;4%;%?%p3%t;7%;%?%p4%t;5%;%?%p5%t;2%;%?%p6%t;1%;m%?%p9%t\x0e%e\x0f%;",
+			caps.SetTab:              "\x1bH",
+			caps.Tab:                 "\t",
+			caps.KeyB2:               "\x1b[G",
+			caps.AcsChars:            "++,,--..00``aaffgghhiijjkkllmmnnooppqqrrssttuuvvwwxxyyzz{{||}}~~",
+			caps.KeyBtab:             "\x1b\t",
+			caps.EnterAmMode:         "\x1b[?7h",
+			caps.ExitAmMode:          "\x1b[?7l",
+			caps.EnaAcs:              "\x1b)0",
+			caps.KeyEnd:              "\x1b[4~",
+			caps.KeySuspend:          "\x1a",
+			caps.KeyF11:              "\x1b[23~",
+			caps.KeyF12:              "\x1b[24~",
+			caps.KeyF13:              "\x1b[25~",
+			caps.KeyF14:              "\x1b[26~",
+			caps.KeyF15:              "\x1b[28~",
+			caps.KeyF16:              "\x1b[29~",
+			caps.KeyF17:              "\x1b[31~",
+			caps.KeyF18:              "\x1b[32~",
+			caps.KeyF19:              "\x1b[33~",
+			caps.KeyF20:              "\x1b[34~",
+			caps.ClrBol:              "\x1b[1K",
+			caps.User6:               "\x1b[%i%d;%dR",
+			caps.User7:               "\x1b[6n",
+			caps.User8:               "\x1b[?6c",
+			caps.User9:               "\x1b[c",
+			caps.OrigPair:            "\x1b[39;49m",
+			caps.OrigColors:          "\x1b]R",
+			caps.InitializeColor:     "\x1b]P%p1%x%p2%{255}%*%{1000}%/%02x%p3%{255}%*%{1000}%/%02x%p4%{255}%*%{1000}%/%02x",
+			caps.KeyMouse:            "\x1b[M",
+			caps.SetAForeground:      "\x1b[3%p1%dm",
+			caps.SetABackground:      "\x1b[4%p1%dm",
+			caps.EnterPcCharsetMode:  "\x1b[11m",
+			caps.ExitPcCharsetMode:   "\x1b[10m",
+		},
+		ExtBools: map[string]bool{
+			"AX": true,
+		},
+		ExtNumbers: map[string]int16{
+			"U8": 1,
+		},
+		ExtStrings: map[string]string{
+			"E3":    "\x1b[3J",
+			"kcbt2": "\x1b[Z",
+		},
+	})
+	terminfo.RegisterBuiltin(&terminfo.Terminfo{
+		Names: []string{"vt100", "vt100-am", "DEC VT100 (w/advanced video)"},
+		Bools: [caps.BoolCount]bool{
+			caps.AutoRightMargin:  true,
+			caps.EatNewlineGlitch: true,
+			caps.MoveStandoutMode: true,
+			caps.XonXoff:          true,
+			caps.PrtrSilent:       true,
+			caps.BackspacesWithBs: true,
+		},
+		Numbers: [caps.NumberCount]int16{
+			caps.Columns:         80,
+			caps.InitTabs:        8,
+			caps.Lines:           24,
+			caps.VirtualTerminal: 3,
+		},
+		Strings: [caps.StringCount]string{
+			caps.Bell:                "\a",
+			caps.CarriageReturn:      "\r",
+			caps.ChangeScrollRegion:  "\x1b[%i%p1%d;%p2%dr",
+			caps.ClearAllTabs:        "\x1b[3g",
+			caps.ClearScreen:         "\x1b[H\x1b[J$<50>",
+			caps.ClrEol:              "\x1b[K$<3>",
+			caps.ClrEos:              "\x1b[J$<50>",
+			caps.CursorAddress:       "\x1b[%i%p1%d;%p2%dH$<5>",
+			caps.CursorDown:          "\n",
+			caps.CursorHome:          "\x1b[H",
+			caps.CursorLeft:          "\b",
+			caps.CursorRight:         "\x1b[C$<2>",
+			caps.CursorUp:            "\x1b[A$<2>",
+			caps.EnterAltCharsetMode: "\x0e",
+			caps.EnterBlinkMode:      "\x1b[5m$<2>",
+			caps.EnterBoldMode:       "\x1b[1m$<2>",
+			caps.EnterReverseMode:    "\x1b[7m$<2>",
+			caps.EnterStandoutMode:   "\x1b[7m$<2>",
+			caps.EnterUnderlineMode:  "\x1b[4m$<2>",
+			caps.ExitAltCharsetMode:  "\x0f",
+			caps.ExitAttributeMode:   "\x1b[m\x0f$<2>",
+			caps.ExitStandoutMode:    "\x1b[m$<2>",
+			caps.ExitUnderlineMode:   "\x1b[m$<2>",
+			caps.KeyBackspace:        "\b",
+			caps.KeyDown:             "\x1bOB",
+			caps.KeyF0:               "\x1bOy",
+			caps.KeyF1:               "\x1bOP",
+			caps.KeyF10:              "\x1bOx",
+			caps.KeyF2:               "\x1bOQ",
+			caps.KeyF3:               "\x1bOR",
+			caps.KeyF4:               "\x1bOS",
+			caps.KeyF5:               "\x1bOt",
+			caps.KeyF6:               "\x1bOu",
+			caps.KeyF7:               "\x1bOv",
+			caps.KeyF8:               "\x1bOl",
+			caps.KeyF9:               "\x1bOw",
+			caps.KeyLeft:             "\x1bOD",
+			caps.KeyRight:            "\x1bOC",
+			caps.KeyUp:               "\x1bOA",
+			caps.KeypadLocal:         "\x1b[?1l\x1b>",
+			caps.KeypadXmit:          "\x1b[?1h\x1b=",
+			caps.LabF1:               "pf1",
+			caps.LabF2:               "pf2",
+			caps.LabF3:               "pf3",
+			caps.LabF4:               "pf4",
+			caps.ParmDownCursor:      "\x1b[%p1%dB",
+			caps.ParmLeftCursor:      "\x1b[%p1%dD",
+			caps.ParmRightCursor:     "\x1b[%p1%dC",
+			caps.ParmUpCursor:        "\x1b[%p1%dA",
+			caps.PrintScreen:         "\x1b[0i",
+			caps.PrtrOff:             "\x1b[4i",
+			caps.PrtrOn:              "\x1b[5i",
+			caps.Reset2string:        "\x1b<\x1b>\x1b[?3;4;5l\x1b[?7;8h\x1b[r",
+			caps.RestoreCursor:       "\x1b8",
+			caps.SaveCursor:          "\x1b7",
+			caps.ScrollForward:       "\n",
+			caps.ScrollReverse:       "\x1bM$<5>",
+			caps.SetAttributes:       "\x1b[0%?%p1%p6%|%t;1%;%?%p2%t;4%;%?%p1%p3%|%t;7%;%?%p4%t;5%;m%?%p9%t\x0e%e\x0f%;$<2>",
+			caps.SetTab:              "\x1bH",
+			caps.Tab:                 "\t",
+			caps.KeyA1:               "\x1bOq",
+			caps.KeyA3:               "\x1bOs",
+			caps.KeyB2:               "\x1bOr",
+			caps.KeyC1:               "\x1bOp",
+			caps.KeyC3:               "\x1bOn",
+			caps.AcsChars:            "``aaffggjjkkllmmnnooppqqrrssttuuvvwwxxyyzz{{||}}~~",
+			caps.EnterAmMode:         "\x1b[?7h",
+			caps.ExitAmMode:          "\x1b[?7l",
+			caps.EnaAcs:              "\x1b(B\x1b)0",
+			caps.KeyEnter:            "\x1bOM",
+			caps.ClrBol:              "\x1b[1K$<3>",
+			caps.User6:               "\x1b[%i%d;%dR",
+			caps.User7:               "\x1b[6n",
+			caps.User8:               "\x1b[?%[;0123456789]c",
+			caps.User9:               "\x1bZ",
+		},
+		ExtBools:   map[string]bool{},
+		ExtNumbers: map[string]int16{},
+		ExtStrings: map[string]string{},
+	})
+	terminfo.RegisterBuiltin(&terminfo.Terminfo{
+		Names: []string{"rxvt-color", "rxvt", "rxvt terminal emulator (X Window System)"},
+		Bools: [caps.BoolCount]bool{
+			caps.AutoRightMargin:  true,
+			caps.EatNewlineGlitch: true,
+			caps.EraseOverstrike:  true,
+			caps.HasMetaKey:       true,
+			caps.MoveInsertMode:   true,
+			caps.MoveStandoutMode: true,
+			caps.XonXoff:          true,
+			caps.BackColorErase:   true,
+			caps.BackspacesWithBs: true,
+		},
+		Numbers: [caps.NumberCount]int16{
+			caps.Columns:   80,
+			caps.InitTabs:  8,
+			caps.Lines:     24,
+			caps.MaxColors: 8,
+			caps.MaxPairs:  64,
+		},
+		Strings: [caps.StringCount]string{
+			caps.Bell:                "\a",
+			caps.CarriageReturn:      "\r",
+			caps.ChangeScrollRegion:  "\x1b[%i%p1%d;%p2%dr",
+			caps.ClearAllTabs:        "\x1b[3g",
+			caps.ClearScreen:         "\x1b[H\x1b[2J",
+			caps.ClrEol:              "\x1b[K",
+			caps.ClrEos:              "\x1b[J",
+			caps.ColumnAddress:       "\x1b[%i%p1%dG",
+			caps.CursorAddress:       "\x1b[%i%p1%d;%p2%dH",
+			caps.CursorDown:          "\n",
+			caps.CursorHome:          "\x1b[H",
+			caps.CursorInvisible:     "\x1b[?25l",
+			caps.CursorLeft:          "\b",
+			caps.CursorNormal:        "\x1b[?25h",
+			caps.CursorRight:         "\x1b[C",
+			caps.CursorUp:            "\x1b[A",
+			caps.DeleteLine:          "\x1b[M",
+			caps.EnterAltCharsetMode: "\x0e",
+			caps.EnterBlinkMode:      "\x1b[5m",
+			caps.EnterBoldMode:       "\x1b[1m",
+			caps.EnterCaMode:         "\x1b7\x1b[?47h",
+			caps.EnterInsertMode:     "\x1b[4h",
+			caps.EnterReverseMode:    "\x1b[7m",
+			caps.EnterStandoutMode:   "\x1b[7m",
+			caps.EnterUnderlineMode:  "\x1b[4m",
+			caps.ExitAltCharsetMode:  "\x0f",
+			caps.ExitAttributeMode:   "\x1b[m\x0f",
+			caps.ExitCaMode:          "\x1b[2J\x1b[?47l\x1b8",
+			caps.ExitInsertMode:      "\x1b[4l",
+			caps.ExitStandoutMode:    "\x1b[27m",
+			caps.ExitUnderlineMode:   "\x1b[24m",
+			caps.FlashScreen:         "\x1b[?5h\x1b[?5l",
+			caps.Init1string:         "\x1b[?47l\x1b=\x1b[?1l",
+			caps.Init2string:         "\x1b[r\x1b[m\x1b[2J\x1b[H\x1b[?7h\x1b[?1;3;4;6l\x1b[4l",
+			caps.InsertCharacter:     "\x1b[@",
+			caps.InsertLine:          "\x1b[L",
+			caps.KeyBackspace:        "\x7f",
+			caps.KeyDc:               "\x1b[3~",
+			caps.KeyDown:             "\x1b[B",
+			caps.KeyEol:              "\x1b[8^",
+			caps.KeyF1:               "\x1b[11~",
+			caps.KeyF10:              "\x1b[21~",
+			caps.KeyF2:               "\x1b[12~",
+			caps.KeyF3:               "\x1b[13~",
+			caps.KeyF4:               "\x1b[14~",
+			caps.KeyF5:               "\x1b[15~",
+			caps.KeyF6:               "\x1b[17~",
+			caps.KeyF7:               "\x1b[18~",
+			caps.KeyF8:               "\x1b[19~",
+			caps.KeyF9:               "\x1b[20~",
+			caps.KeyHome:             "\x1b[7~",
+			caps.KeyIc:               "\x1b[2~",
+			caps.KeyLeft:             "\x1b[D",
+			caps.KeyNpage:            "\x1b[6~",
+			caps.KeyPpage:            "\x1b[5~",
+			caps.KeyRight:            "\x1b[C",
+			caps.KeyUp:               "\x1b[A",
+			caps.KeypadLocal:         "\x1b>",
+			caps.KeypadXmit:          "\x1b=",
+			caps.ParmDeleteLine:      "\x1b[%p1%dM",
+			caps.ParmDownCursor:      "\x1b[%p1%dB",
+			caps.ParmIch:             "\x1b[%p1%d@",
+			caps.ParmInsertLine:      "\x1b[%p1%dL",
+			caps.ParmLeftCursor:      "\x1b[%p1%dD",
+			caps.ParmRightCursor:     "\x1b[%p1%dC",
+			caps.ParmUpCursor:        "\x1b[%p1%dA",
+			caps.Reset1string:        "\x1b>\x1b[1;3;4;5;6l\x1b[?7h\x1b[m\x1b[r\x1b[2J\x1b[H",
+			caps.Reset2string:        "\x1b[r\x1b[m\x1b[2J\x1b[H\x1b[?7h\x1b[?1;3;4;6l\x1b[4l\x1b=\x1b[?1000l\x1b[?25h",
+			caps.RestoreCursor:       "\x1b8",
+			caps.RowAddress:          "\x1b[%i%p1%dd",
+			caps.SaveCursor:          "\x1b7",
+			caps.ScrollForward:       "\n",
+			caps.ScrollReverse:       "\x1bM",
+			caps.SetAttributes:       "\x1b[0%?%p6%t;1%;%?%p2%t;4%;%?%p1%p3%|%t;7%;%?%p4%t;5%;m%?%p9%t\x0e%e\x0f%;",
+			caps.SetTab:              "\x1bH",
+			caps.Tab:                 "\t",
+			caps.KeyA1:               "\x1bOw",
+			caps.KeyA3:               "\x1bOy",
+			caps.KeyB2:               "\x1bOu",
+			caps.KeyC1:               "\x1bOq",
+			caps.KeyC3:               "\x1bOs",
+			caps.AcsChars:            "``aaffggjjkkllmmnnooppqqrrssttuuvvwwxxyyzz{{||}}~~",
+			caps.KeyBtab:             "\x1b[Z",
+			caps.EnaAcs:              "\x1b(B\x1b)0",
+			caps.KeyEnd:              "\x1b[8~",
+			caps.KeyEnter:            "\x1bOM",
+			caps.KeyFind:             "\x1b[1~",
+			caps.KeySdc:              "\x1b[3$",
+			caps.KeySelect:           "\x1b[4~",
+			caps.KeySend:             "\x1b[8$",
+			caps.KeyShome:            "\x1b[7$",
+			caps.KeySleft:            "\x1b[d",
+			caps.KeySnext:            "\x1b[6$",
+			caps.KeySprevious:        "\x1b[5$",
+			caps.KeySright:           "\x1b[c",
+			caps.KeyF11:              "\x1b[23~",
+			caps.KeyF12:              "\x1b[24~",
+			caps.KeyF13:              "\x1b[25~",
+			caps.KeyF14:              "\x1b[26~",
+			caps.KeyF15:              "\x1b[28~",
+			caps.KeyF16:              "\x1b[29~",
+			caps.KeyF17:              "\x1b[31~",
+			caps.KeyF18:              "\x1b[32~",
+			caps.KeyF19:              "\x1b[33~",
+			caps.KeyF20:              "\x1b[34~",
+			caps.KeyF21:              "\x1b[23$",
+			caps.KeyF22:              "\x1b[24$",
+			caps.KeyF23:              "\x1b[11^",
+			caps.KeyF24:              "\x1b[12^",
+			caps.KeyF25:              "\x1b[13^",
+			caps.KeyF26:              "\x1b[14^",
+			caps.KeyF27:              "\x1b[15^",
+			caps.KeyF28:              "\x1b[17^",
+			caps.KeyF29:              "\x1b[18^",
+			caps.KeyF30:              "\x1b[19^",
+			caps.KeyF31:              "\x1b[20^",
+			caps.KeyF32:              "\x1b[21^",
+			caps.KeyF33:              "\x1b[23^",
+			caps.KeyF34:              "\x1b[24^",
+			caps.KeyF35:              "\x1b[25^",
+			caps.KeyF36:              "\x1b[26^",
+			caps.KeyF37:              "\x1b[28^",
+			caps.KeyF38:              "\x1b[29^",
+			caps.KeyF39:              "\x1b[31^",
+			caps.KeyF40:              "\x1b[32^",
+			caps.KeyF41:              "\x1b[33^",
+			caps.KeyF42:              "\x1b[34^",
+			caps.KeyF43:              "\x1b[23@",
+			caps.KeyF44:              "\x1b[24@",
+			caps.ClrBol:              "\x1b[1K",
+			caps.OrigPair:            "\x1b[39;49m",
+			caps.KeyMouse:            "\x1b[M",
+			caps.SetAForeground:      "\x1b[3%p1%dm",
+			caps.SetABackground:      "\x1b[4%p1%dm",
+			caps.Set0DesSeq:          "\x1b(B",
+			caps.Set1DesSeq:          "\x1b(0",
+		},
+		ExtBools: map[string]bool{
+			"AX": true,
+		},
+		ExtNumbers: map[string]int16{},
+		ExtStrings: map[string]string{
+			"kDN":   "\x1b[b",
+			"kDN5":  "\x1bOb",
+			"kDN6":  "\x1bOB",
+			"kLFT5": "\x1bOd",
+			"kLFT6": "\x1bOD",
+			"kRIT5": "\x1bOc",
+			"kRIT6": "\x1bOC",
+			"kUP":   "\x1b[a",
+			"kUP5":  "\x1bOa",
+			"kUP6":  "\x1bOA",
+			"ka2":   "\x1bOx",
+			"kb1":   "\x1bOt",
+			"kb3":   "\x1bOv",
+			"kc2":   "\x1bOr",
+		},
+	})
+}
@@ -0,0 +1,220 @@
+//go:build ignore
+// +build ignore
+
+// mkbuiltin generates entries.go from terminfo source, such as the
+// misc/terminfo.src file of ncurses, or from the compiled terminfo entries
+// found on the system running it. Aliases that distributions add to the
+// entries, such as xterm-debian, are left out.
+//
+// Usage:
+//
+//	go run mkbuiltin.go [-o entries.go] [-src terminfo.src] [names...]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/nhooyr/terminfo"
+	"github.com/nhooyr/terminfo/caps"
+)
+
+var defaultNames = []string{
+	"xterm",
+	"xterm-256color",
+	"screen",
+	"tmux",
+	"linux",
+	"vt100",
+	"rxvt",
+}
+
+func main() {
+	out := flag.String("o", "entries.go", "output file")
+	src := flag.String("src", "", "terminfo source file to read the entries from instead of the system's database")
+	flag.Parse()
+	names := flag.Args()
+	if len(names) == 0 {
+		names = defaultNames
+	}
+	load := terminfo.Load
+	if *src != "" {
+		load = sourceLoader(*src)
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by mkbuiltin.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package builtin")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "import (")
+	fmt.Fprintln(buf, `"github.com/nhooyr/terminfo"`)
+	fmt.Fprintln(buf, `"github.com/nhooyr/terminfo/caps"`)
+	fmt.Fprintln(buf, ")")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "func init() {")
+	for _, name := range names {
+		ti, err := load(name)
+		if err != nil {
+			log.Fatalf("%s: %v", name, err)
+		}
+		writeEntry(buf, withName(withoutDistroAliases(ti), name))
+	}
+	fmt.Fprintln(buf, "}")
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(*out, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// sourceLoader returns a function that looks up entries in the terminfo
+// source file.
+func sourceLoader(file string) func(string) (*terminfo.Terminfo, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	entries, err := terminfo.ParseSource(f)
+	if err != nil {
+		log.Fatalf("%s: %v", file, err)
+	}
+	byName := make(map[string]*terminfo.Terminfo)
+	for _, ti := range entries {
+		for _, n := range ti.Names {
+			byName[n] = ti
+		}
+	}
+	return func(name string) (*terminfo.Terminfo, error) {
+		ti, ok := byName[name]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		return ti, nil
+	}
+}
+
+// distroSuffixes are the suffixes of aliases that distributions add to the
+// entries they ship.
+var distroSuffixes = []string{"-debian"}
+
+// withoutDistroAliases removes the aliases added by distributions from the
+// names of ti, so that the entries do not depend on the system they were
+// generated on.
+func withoutDistroAliases(ti *terminfo.Terminfo) *terminfo.Terminfo {
+	var names []string
+	for i, n := range ti.Names {
+		distro := false
+		for _, s := range distroSuffixes {
+			// The description is always last and kept.
+			distro = distro || i < len(ti.Names)-1 && strings.HasSuffix(n, s)
+		}
+		if !distro {
+			names = append(names, n)
+		}
+	}
+	ti.Names = names
+	return ti
+}
+
+// withName makes sure that name is one of the names of ti, as some systems
+// install entries under a name that is not listed in the entry itself.
+// The name is added before the description, which is always last.
+func withName(ti *terminfo.Terminfo, name string) *terminfo.Terminfo {
+	for _, n := range ti.Names {
+		if n == name {
+			return ti
+		}
+	}
+	last := len(ti.Names) - 1
+	names := append([]string{}, ti.Names[:last]...)
+	ti.Names = append(append(names, name), ti.Names[last])
+	return ti
+}
+
+// writeEntry writes a terminfo.RegisterBuiltin call for ti.
+func writeEntry(buf *bytes.Buffer, ti *terminfo.Terminfo) {
+	fmt.Fprintln(buf, "terminfo.RegisterBuiltin(&terminfo.Terminfo{")
+	fmt.Fprintf(buf, "Names: %#v,\n", ti.Names)
+	fmt.Fprintln(buf, "Bools: [caps.BoolCount]bool{")
+	for i, v := range ti.Bools {
+		if v {
+			fmt.Fprintf(buf, "caps.%s: true,\n", constName(caps.BoolLongNames[i]))
+		}
+	}
+	fmt.Fprintln(buf, "},")
+	fmt.Fprintln(buf, "Numbers: [caps.NumberCount]int16{")
+	for i, v := range ti.Numbers {
+		if v != 0 {
+			fmt.Fprintf(buf, "caps.%s: %d,\n", constName(caps.NumberLongNames[i]), v)
+		}
+	}
+	fmt.Fprintln(buf, "},")
+	fmt.Fprintln(buf, "Strings: [caps.StringCount]string{")
+	for i, v := range ti.Strings {
+		if v != "" {
+			fmt.Fprintf(buf, "caps.%s: %q,\n", constName(caps.StringLongNames[i]), v)
+		}
+	}
+	fmt.Fprintln(buf, "},")
+	fmt.Fprintln(buf, "ExtBools: map[string]bool{")
+	for _, k := range sortedKeys(len(ti.ExtBools), func(f func(string)) {
+		for k := range ti.ExtBools {
+			f(k)
+		}
+	}) {
+		fmt.Fprintf(buf, "%q: %v,\n", k, ti.ExtBools[k])
+	}
+	fmt.Fprintln(buf, "},")
+	fmt.Fprintln(buf, "ExtNumbers: map[string]int16{")
+	for _, k := range sortedKeys(len(ti.ExtNumbers), func(f func(string)) {
+		for k := range ti.ExtNumbers {
+			f(k)
+		}
+	}) {
+		fmt.Fprintf(buf, "%q: %d,\n", k, ti.ExtNumbers[k])
+	}
+	fmt.Fprintln(buf, "},")
+	fmt.Fprintln(buf, "ExtStrings: map[string]string{")
+	for _, k := range sortedKeys(len(ti.ExtStrings), func(f func(string)) {
+		for k := range ti.ExtStrings {
+			f(k)
+		}
+	}) {
+		fmt.Fprintf(buf, "%q: %q,\n", k, ti.ExtStrings[k])
+	}
+	fmt.Fprintln(buf, "},")
+	fmt.Fprintln(buf, "})")
+}
+
+// sortedKeys collects the keys produced by each and sorts them so that the
+// output is deterministic.
+func sortedKeys(n int, each func(func(string))) []string {
+	keys := make([]string, 0, n)
+	each(func(k string) {
+		keys = append(keys, k)
+	})
+	sort.Strings(keys)
+	return keys
+}
+
+// constName converts a long capability name such as set_a_foreground into
+// the name of its constant in the caps package, SetAForeground.
+func constName(long string) string {
+	parts := strings.Split(long, "_")
+	for i, p := range parts {
+		parts[i] = strings.ToUpper(p[:1]) + p[1:]
+	}
+	return strings.Join(parts, "")
+}
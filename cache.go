@@ -0,0 +1,153 @@
+package terminfo
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Cache holds decoded entries by name so they are only read once.
+// It holds its own copies of the entries, so modifying an entry that was
+// added or returned never affects other users of the cache.
+// It is safe for concurrent use. The zero value is an empty cache without
+// a size limit.
+type Cache struct {
+	mu       sync.Mutex
+	max      int
+	disabled bool
+	entries  map[string]*list.Element
+	lru      list.List // of *cacheEntry, most recently used first
+}
+
+// cacheEntry is an entry in the lru list of a Cache.
+type cacheEntry struct {
+	name string
+	ti   *Terminfo
+}
+
+// DefaultCache is the cache used by Load.
+var DefaultCache = NewCache(0)
+
+// NewCache returns a cache holding at most max names, evicting the least
+// recently used ones. If max is 0 or less, the cache is not limited.
+func NewCache(max int) *Cache {
+	return &Cache{max: max}
+}
+
+// Get returns a copy of the entry cached under the name.
+func (c *Cache) Get(name string) (*Terminfo, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[name]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*cacheEntry).ti.Clone(), true
+}
+
+// Add caches a copy of ti under each of the names.
+func (c *Cache) Add(ti *Terminfo, names ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.disabled {
+		return
+	}
+	ti = ti.Clone()
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+	}
+	for _, name := range names {
+		if e, ok := c.entries[name]; ok {
+			e.Value.(*cacheEntry).ti = ti
+			c.lru.MoveToFront(e)
+			continue
+		}
+		c.entries[name] = c.lru.PushFront(&cacheEntry{name, ti})
+	}
+	c.evict()
+}
+
+// evict removes the least recently used names until the cache is within
+// its limit.
+func (c *Cache) evict() {
+	for c.max > 0 && c.lru.Len() > c.max {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove removes the list element e.
+func (c *Cache) remove(e *list.Element) {
+	c.lru.Remove(e)
+	delete(c.entries, e.Value.(*cacheEntry).name)
+}
+
+// Delete removes the entry cached under the name, along with the other
+// names it is cached under.
+func (c *Cache) Delete(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[name]
+	if !ok {
+		return
+	}
+	ti := e.Value.(*cacheEntry).ti
+	for _, n := range ti.Names {
+		if e, ok := c.entries[n]; ok && e.Value.(*cacheEntry).ti == ti {
+			c.remove(e)
+		}
+	}
+	if e, ok := c.entries[name]; ok {
+		c.remove(e)
+	}
+}
+
+// deleteFile removes the entries read from the compiled entry file.
+func (c *Cache) deleteFile(file string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.entries {
+		for _, o := range e.Value.(*cacheEntry).ti.Origins {
+			if o.Kind == "file" && o.Name == file {
+				c.remove(e)
+				break
+			}
+		}
+	}
+}
+
+// Purge removes all entries, for example after the terminfo database
+// was updated.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = nil
+	c.lru.Init()
+}
+
+// Len returns the number of names cached.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// SetMaxEntries changes the maximum number of names held, evicting names
+// if needed. If max is 0 or less, the cache is not limited.
+func (c *Cache) SetMaxEntries(max int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.max = max
+	c.evict()
+}
+
+// SetEnabled enables or disables caching. Disabling the cache purges it
+// and every Load reads the entry again until it is enabled.
+func (c *Cache) SetEnabled(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.disabled = !enabled
+	if c.disabled {
+		c.entries = nil
+		c.lru.Init()
+	}
+}
@@ -0,0 +1,65 @@
+package terminfo
+
+import (
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestCache(t *testing.T) {
+	c := NewCache(2)
+	a := &Terminfo{Names: []string{"a", "alias"}}
+	b := &Terminfo{Names: []string{"b"}}
+	c.Add(a, a.Names...)
+	if ti, ok := c.Get("alias"); !ok || ti.Names[0] != "a" {
+		t.Fatal("a not cached under its alias")
+	}
+	a.Names[0] = "changed"
+	if ti, _ := c.Get("a"); ti.Names[0] != "a" {
+		t.Error("modifying an added entry modified the cache")
+	}
+	c.Get("a")
+	c.Add(b, b.Names...)
+	if _, ok := c.Get("alias"); ok {
+		t.Error("least recently used name was not evicted")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("recently used name was evicted")
+	}
+	c.Delete("a")
+	if c.Len() != 1 {
+		t.Errorf("Len = %d after Delete, want 1", c.Len())
+	}
+	c.Purge()
+	if c.Len() != 0 {
+		t.Errorf("Len = %d after Purge, want 0", c.Len())
+	}
+	c.SetEnabled(false)
+	c.Add(b, b.Names...)
+	if _, ok := c.Get("b"); ok {
+		t.Error("disabled cache holds entries")
+	}
+}
+
+func TestDefaultCache(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := DefaultCache.Get("xterm"); !ok {
+		t.Fatal("Load did not use DefaultCache")
+	}
+	ti.Strings[caps.PadChar] = "*"
+	ti.ExtBools["Tc"] = true
+	nti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nti == ti || nti.Strings[caps.PadChar] == "*" || nti.ExtBools["Tc"] {
+		t.Error("modifying a loaded entry modified the cached one")
+	}
+	DefaultCache.Delete("xterm")
+	if _, ok := DefaultCache.Get("xterm"); ok {
+		t.Error("entry still cached after Delete")
+	}
+}
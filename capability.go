@@ -0,0 +1,210 @@
+package terminfo
+
+//go:generate go run mkaccessors.go -o accessors.go
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// CapKind is the type of a capability.
+type CapKind int
+
+// These are the kinds of capabilities.
+const (
+	CapUnknown CapKind = iota // not a capability of the entry
+	CapBool
+	CapNumber
+	CapString
+)
+
+// Capability is the value of a boolean, number or string capability.
+// Only the field for its Kind is used.
+type Capability struct {
+	Kind CapKind
+	// Present is false if the entry lacks the capability, such as a
+	// standard capability that is false, 0 or empty.
+	Present bool
+	Bool    bool
+	Num     int
+	Str     string
+}
+
+// String returns the value as written in the source format, such as "#80"
+// or "=\E[H", or "" for a boolean or an absent capability.
+func (c Capability) String() string {
+	if !c.Present {
+		return ""
+	}
+	switch c.Kind {
+	case CapNumber:
+		return "#" + strconv.Itoa(c.Num)
+	case CapString:
+		return "=" + EscapeSource(c.Str)
+	}
+	return ""
+}
+
+// CapState is the state of a standard capability whose value is 0 or empty.
+type CapState int
+
+// These are the states of capabilities.
+const (
+	CapAbsent    CapState = iota // not in the entry
+	CapEmpty                     // present with the value 0 or ""
+	CapCancelled                 // cancelled with @ in the source
+)
+
+// These are the values returned by Number for numbers that are not present.
+const (
+	NumAbsent    = -1
+	NumCancelled = -2
+)
+
+// Number returns the value of the standard number capability i, or
+// NumAbsent or NumCancelled if the entry does not have it.
+func (ti *Terminfo) Number(i int) int {
+	if v := ti.Numbers[i]; v != 0 {
+		return int(v)
+	}
+	switch ti.CapStates[caps.NumberNames[i]] {
+	case CapEmpty:
+		return 0
+	case CapCancelled:
+		return NumCancelled
+	}
+	return NumAbsent
+}
+
+// stringCap returns the standard string capability i. ok is false if the
+// entry does not have it, as opposed to having an empty string.
+func (ti *Terminfo) stringCap(i int) (s string, ok bool) {
+	if s = ti.Strings[i]; s != "" {
+		return s, true
+	}
+	return "", ti.CapStates[caps.StringNames[i]] == CapEmpty
+}
+
+// setCapState records the state of the standard capability with the short
+// name, whose value in the arrays is zero.
+func (ti *Terminfo) setCapState(name string, st CapState) {
+	if st == CapAbsent {
+		delete(ti.CapStates, name)
+		return
+	}
+	if ti.CapStates == nil {
+		ti.CapStates = make(map[string]CapState)
+	}
+	ti.CapStates[name] = st
+}
+
+// zeroState returns CapEmpty if empty is true and CapAbsent otherwise.
+func zeroState(empty bool) CapState {
+	if empty {
+		return CapEmpty
+	}
+	return CapAbsent
+}
+
+// ErrCapKind is returned by Set when the kind of the value does not match
+// the kind of the standard capability.
+var ErrCapKind = errors.New("terminfo: capability kind mismatch")
+
+// Get returns the capability with the short or long name, falling back to the
+// extended capabilities if the name is not a standard one. The Kind is
+// CapUnknown if there is no such capability.
+func (ti *Terminfo) Get(name string) Capability {
+	if i, ok := caps.LookupBool(name); ok {
+		v := ti.Bools[i]
+		return Capability{Kind: CapBool, Present: v, Bool: v}
+	}
+	if i, ok := caps.LookupNumber(name); ok {
+		v := ti.Number(i)
+		if v < 0 {
+			return Capability{Kind: CapNumber}
+		}
+		return Capability{Kind: CapNumber, Present: true, Num: v}
+	}
+	if i, ok := caps.LookupString(name); ok {
+		v, ok := ti.stringCap(i)
+		return Capability{Kind: CapString, Present: ok, Str: v}
+	}
+	if v, ok := ti.ExtBools[name]; ok {
+		return Capability{Kind: CapBool, Present: true, Bool: v}
+	}
+	if v, ok := ti.ExtNumbers[name]; ok {
+		return Capability{Kind: CapNumber, Present: true, Num: int(v)}
+	}
+	if v, ok := ti.ExtStrings[name]; ok {
+		return Capability{Kind: CapString, Present: true, Str: v}
+	}
+	return Capability{}
+}
+
+// Set sets the capability with the short or long name to c. Names that are
+// not standard are set as extended capabilities of the kind of c. If c is
+// not Present, the capability is removed. Numbers are clamped to the range
+// of the compiled format.
+func (ti *Terminfo) Set(name string, c Capability) error {
+	if c.Kind == CapUnknown {
+		return ErrCapKind
+	}
+	if !c.Present {
+		c = Capability{Kind: c.Kind}
+	}
+	if c.Num > math.MaxInt16 {
+		c.Num = math.MaxInt16
+	} else if c.Num < math.MinInt16 {
+		c.Num = math.MinInt16
+	}
+	if i, ok := caps.LookupBool(name); ok {
+		if c.Kind != CapBool {
+			return ErrCapKind
+		}
+		ti.Bools[i] = c.Bool
+		return nil
+	}
+	if i, ok := caps.LookupNumber(name); ok {
+		if c.Kind != CapNumber {
+			return ErrCapKind
+		}
+		ti.Numbers[i] = int16(c.Num)
+		ti.setCapState(caps.NumberNames[i], zeroState(c.Present && c.Num == 0))
+		return nil
+	}
+	if i, ok := caps.LookupString(name); ok {
+		if c.Kind != CapString {
+			return ErrCapKind
+		}
+		ti.Strings[i] = c.Str
+		ti.setCapState(caps.StringNames[i], zeroState(c.Present && c.Str == ""))
+		return nil
+	}
+	delete(ti.ExtBools, name)
+	delete(ti.ExtNumbers, name)
+	delete(ti.ExtStrings, name)
+	if !c.Present {
+		return nil
+	}
+	switch c.Kind {
+	case CapBool:
+		if ti.ExtBools == nil {
+			ti.ExtBools = make(map[string]bool)
+		}
+		ti.ExtBools[name] = c.Bool
+	case CapNumber:
+		if ti.ExtNumbers == nil {
+			ti.ExtNumbers = make(map[string]int16)
+		}
+		ti.ExtNumbers[name] = int16(c.Num)
+	case CapString:
+		if ti.ExtStrings == nil {
+			ti.ExtStrings = make(map[string]string)
+		}
+		ti.ExtStrings[name] = c.Str
+	}
+	return nil
+}
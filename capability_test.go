@@ -0,0 +1,163 @@
+package terminfo
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+	"github.com/nhooyr/terminfo/extcaps"
+)
+
+func TestGetSet(t *testing.T) {
+	lti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti := *lti
+	ti.ExtBools = map[string]bool{}
+	ti.ExtNumbers = map[string]int16{}
+	ti.ExtStrings = map[string]string{}
+	for name, want := range map[string]string{
+		"am":             "",
+		"cols":           "#80",
+		"cursor_address": `=\E[%i%p1%d;%p2%dH`,
+	} {
+		c := ti.Get(name)
+		if !c.Present || c.String() != want {
+			t.Errorf("Get(%q) = %+v, want %q", name, c, want)
+		}
+	}
+	if c := ti.Get("nope"); c.Kind != CapUnknown {
+		t.Errorf("Get(nope) = %+v", c)
+	}
+
+	if err = ti.Set("cols", Capability{Kind: CapNumber, Present: true, Num: 1 << 20}); err != nil {
+		t.Fatal(err)
+	}
+	if ti.Numbers[caps.Columns] != 32767 {
+		t.Errorf("cols = %d, want 32767", ti.Numbers[caps.Columns])
+	}
+	if err = ti.Set("am", Capability{Kind: CapBool}); err != nil || ti.Bools[caps.AutoRightMargin] {
+		t.Errorf("am was not removed: %v", err)
+	}
+	if err = ti.Set("cup", Capability{Kind: CapBool, Present: true, Bool: true}); err != ErrCapKind {
+		t.Errorf("got %v, want ErrCapKind", err)
+	}
+	ti.Set("Tc", Capability{Kind: CapBool, Present: true, Bool: true})
+	if c := ti.Get("Tc"); c.Kind != CapBool || !c.Bool {
+		t.Errorf("Get(Tc) = %+v", c)
+	}
+	ti.Set("Tc", Capability{Kind: CapString, Present: true, Str: "x"})
+	if _, ok := ti.ExtBools["Tc"]; ok || ti.ExtStrings["Tc"] != "x" {
+		t.Error("Set did not replace the kind of an extended capability")
+	}
+	ti.Set("Tc", Capability{Kind: CapString})
+	if c := ti.Get("Tc"); c.Kind != CapUnknown {
+		t.Errorf("Tc was not removed: %+v", c)
+	}
+}
+
+func TestCapStates(t *testing.T) {
+	ti, err := Load("rxvt-unicode")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := ti.Number(caps.LinesOfMemory); n != 0 {
+		t.Errorf("lm = %d, want 0", n)
+	}
+	if n := ti.Number(caps.VirtualTerminal); n != NumAbsent {
+		t.Errorf("vt = %d, want NumAbsent", n)
+	}
+	if s, ok := ti.EnaAcs(); !ok || s != "" {
+		t.Errorf("EnaAcs = %q, %v, want an empty string", s, ok)
+	}
+	if s, ok := ti.CursorAddress(); !ok || s != ti.Strings[caps.CursorAddress] {
+		t.Errorf("CursorAddress = %q, %v", s, ok)
+	}
+	if _, ok := ti.FlashHook(); ok {
+		t.Error("FlashHook is present")
+	}
+
+	tis, err := ParseSource(strings.NewReader("test-states|test,\n\tlm#0, it@, rmacs=, smacs@, use=vt100,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti = tis[0]
+	b, err := EncodeBytes(ti)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti, err = DecodeBytes(b); err != nil {
+		t.Fatal(err)
+	}
+	if n := ti.Number(caps.InitTabs); n != NumCancelled {
+		t.Errorf("it = %d, want NumCancelled", n)
+	}
+	if c := ti.Get("lm"); !c.Present || c.Num != 0 {
+		t.Errorf("Get(lm) = %+v, want 0", c)
+	}
+	if _, ok := ti.ExitAltCharsetMode(); !ok {
+		t.Error("rmacs is absent, want an empty string")
+	}
+	if _, ok := ti.EnterAltCharsetMode(); ok || ti.CapStates["smacs"] != CapCancelled {
+		t.Error("smacs was not cancelled")
+	}
+	if got, want := ti.Describe(), "test-states|test,\n"; !strings.HasPrefix(got, want) ||
+		!strings.Contains(got, "\tit@,\n") || !strings.Contains(got, "\tlm#0,\n") || !strings.Contains(got, "\trmacs=,\n") {
+		t.Errorf("Describe:\n%s", got)
+	}
+}
+
+func TestExtAccessors(t *testing.T) {
+	ti := &Terminfo{}
+	if _, ok := ti.ExtString("XM"); ok || ti.ExtBool("AX") {
+		t.Error("found capabilities in an empty entry")
+	}
+	ti.Set("AX", Capability{Kind: CapBool, Present: true, Bool: true})
+	ti.Set("U8", Capability{Kind: CapNumber, Present: true, Num: 1})
+	ti.Set("XM", Capability{Kind: CapString, Present: true, Str: "\x1b[?1000h"})
+	if n, ok := ti.ExtNumber("U8"); !ok || n != 1 || !ti.ExtBool("AX") {
+		t.Errorf("ExtNumber = %d, %v; ExtBool = %v", n, ok, ti.ExtBool("AX"))
+	}
+	bools, nums, strs := ti.ExtNames()
+	if len(bools) != 1 || len(nums) != 1 || len(strs) != 1 || strs[0] != "XM" {
+		t.Errorf("ExtNames = %v, %v, %v", bools, nums, strs)
+	}
+
+	if s, err := ti.ExtStringCap(extcaps.XM); err != nil || s != "\x1b[?1000h" {
+		t.Errorf("ExtStringCap(XM) = %q, %v", s, err)
+	}
+	if _, err := ti.ExtStringCap(extcaps.Ss); err != ErrExtAbsent {
+		t.Errorf("got %v, want ErrExtAbsent", err)
+	}
+	ti.Set("Tc", Capability{Kind: CapString, Present: true, Str: "x"})
+	if _, err := ti.ExtBoolCap(extcaps.Tc); err != ErrCapKind {
+		t.Errorf("got %v, want ErrCapKind", err)
+	}
+	if n, err := ti.ExtNumberCap(extcaps.U8); err != nil || n != 1 {
+		t.Errorf("ExtNumberCap(U8) = %d, %v", n, err)
+	}
+
+	// Each loaded copy may be read while another is changed, run with -race.
+	r, err := Load("rxvt-unicode-256color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := Load("rxvt-unicode-256color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			w.Set("XM", Capability{Kind: CapString, Present: true, Str: strconv.Itoa(i)})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		r.ExtString("XM")
+		r.ExtNames()
+	}
+	<-done
+}
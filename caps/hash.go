@@ -0,0 +1,95 @@
+package caps
+
+import "sort"
+
+// nameTable is a perfect hash table from names to capability indexes, built
+// with hash and displace: a first hash picks a bucket and the seed of the
+// bucket picks the slot, so a lookup hashes the name twice and compares it
+// once, without allocating.
+type nameTable struct {
+	seeds []uint32
+	names []string
+	index []int
+}
+
+// hashName is FNV-1a seeded with seed.
+func hashName(name string, seed uint32) uint32 {
+	h := 2166136261 ^ seed*16777619
+	for i := 0; i < len(name); i++ {
+		h ^= uint32(name[i])
+		h *= 16777619
+	}
+	return h
+}
+
+// newNameTable returns a nameTable holding the entries of m.
+func newNameTable(m map[string]int) *nameTable {
+	size := 1
+	for size < 2*len(m) {
+		size <<= 1
+	}
+	nb := size / 8
+	if nb == 0 {
+		nb = 1
+	}
+	t := &nameTable{
+		seeds: make([]uint32, nb),
+		names: make([]string, size),
+		index: make([]int, size),
+	}
+	buckets := make([][]string, nb)
+	for name := range m {
+		b := hashName(name, 0) & uint32(nb-1)
+		buckets[b] = append(buckets[b], name)
+	}
+	// Place the largest buckets first, while the table is mostly empty.
+	order := make([]int, nb)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(buckets[order[i]]) > len(buckets[order[j]])
+	})
+	used := make([]bool, size)
+	slots := make([]uint32, 0, 8)
+	for _, b := range order {
+		if len(buckets[b]) == 0 {
+			break
+		}
+	seeds:
+		for seed := uint32(1); ; seed++ {
+			slots = slots[:0]
+			for _, name := range buckets[b] {
+				s := hashName(name, seed) & uint32(size-1)
+				if used[s] {
+					continue seeds
+				}
+				for _, o := range slots {
+					if o == s {
+						continue seeds
+					}
+				}
+				slots = append(slots, s)
+			}
+			t.seeds[b] = seed
+			for i, name := range buckets[b] {
+				used[slots[i]] = true
+				t.names[slots[i]] = name
+				t.index[slots[i]] = m[name]
+			}
+			break
+		}
+	}
+	return t
+}
+
+// lookup returns the index of the name.
+func (t *nameTable) lookup(name string) (int, bool) {
+	b := hashName(name, 0) & uint32(len(t.seeds)-1)
+	s := hashName(name, t.seeds[b]) & uint32(len(t.names)-1)
+	// Empty slots hold "", which is not a name.
+	if name == "" || t.names[s] != name {
+		return 0, false
+	}
+	return t.index[s], true
+}
@@ -0,0 +1,75 @@
+package caps
+
+//go:generate go run mkcaps.go -caps $NCURSES_SRC/include/Caps -o names.go
+
+// Name to index tables.
+var (
+	boolIndex   = newNameTable(makeIndex(BoolNames[:], BoolLongNames[:]))
+	numberIndex = newNameTable(makeIndex(NumberNames[:], NumberLongNames[:]))
+	stringIndex = newNameTable(makeIndex(StringNames[:], StringLongNames[:]))
+)
+
+// Termcap name to index tables.
+var (
+	boolCodeIndex   = newNameTable(makeCodeIndex(BoolCodes[:]))
+	numberCodeIndex = newNameTable(makeCodeIndex(NumberCodes[:]))
+	stringCodeIndex = newNameTable(makeCodeIndex(StringCodes[:]))
+)
+
+// makeIndex builds a map from both the short and long names to the index.
+func makeIndex(short, long []string) map[string]int {
+	m := make(map[string]int, len(short)+len(long))
+	for i := range short {
+		m[short[i]] = i
+		m[long[i]] = i
+	}
+	return m
+}
+
+// makeCodeIndex builds a map from the termcap names to the index.
+// Some termcap names are shared by several capabilities, the first one wins.
+func makeCodeIndex(codes []string) map[string]int {
+	m := make(map[string]int, len(codes))
+	for i := len(codes) - 1; i >= 0; i-- {
+		if codes[i] != "" {
+			m[codes[i]] = i
+		}
+	}
+	return m
+}
+
+// LookupBool returns the index of the boolean capability with the given
+// short name (e.g. "am") or long name (e.g. "auto_right_margin").
+func LookupBool(name string) (int, bool) {
+	return boolIndex.lookup(name)
+}
+
+// LookupNumber returns the index of the number capability with the given
+// short name (e.g. "colors") or long name (e.g. "max_colors").
+func LookupNumber(name string) (int, bool) {
+	return numberIndex.lookup(name)
+}
+
+// LookupString returns the index of the string capability with the given
+// short name (e.g. "cup") or long name (e.g. "cursor_address").
+func LookupString(name string) (int, bool) {
+	return stringIndex.lookup(name)
+}
+
+// LookupBoolCode returns the index of the boolean capability with the given
+// termcap name.
+func LookupBoolCode(code string) (int, bool) {
+	return boolCodeIndex.lookup(code)
+}
+
+// LookupNumberCode returns the index of the number capability with the given
+// termcap name.
+func LookupNumberCode(code string) (int, bool) {
+	return numberCodeIndex.lookup(code)
+}
+
+// LookupStringCode returns the index of the string capability with the given
+// termcap name.
+func LookupStringCode(code string) (int, bool) {
+	return stringCodeIndex.lookup(code)
+}
@@ -0,0 +1,92 @@
+package caps
+
+import "strings"
+
+// Type is the type of a capability.
+type Type int
+
+// These are the types of capabilities.
+const (
+	TypeBool Type = iota
+	TypeNumber
+	TypeString
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeBool:
+		return "bool"
+	case TypeNumber:
+		return "num"
+	case TypeString:
+		return "str"
+	}
+	return "unknown"
+}
+
+// Cap describes a standard capability.
+type Cap struct {
+	Type Type
+	// Index is the index of the capability among those of its type, such
+	// as StringNames, and the value of its constant, such as CursorAddress.
+	Index int
+	// Name is the short terminfo name, e.g. "cup".
+	Name string
+	// LongName is the variable name used by terminfo(5) and the C
+	// library, e.g. "cursor_address".
+	LongName string
+	// Code is the termcap name, e.g. "cm", or empty if there is none.
+	Code string
+	// GoName is the name of the constant in this package, e.g.
+	// "CursorAddress".
+	GoName string
+}
+
+// Metadata of the standard capabilities, indexed by capability.
+var (
+	BoolCaps   [BoolCount]Cap
+	NumberCaps [NumberCount]Cap
+	StringCaps [StringCount]Cap
+)
+
+// All holds the metadata of all standard capabilities: the booleans,
+// followed by the numbers and the strings.
+var All []Cap
+
+// Metadata of the standard capabilities by short name.
+var (
+	BoolByName   = make(map[string]Cap, BoolCount)
+	NumberByName = make(map[string]Cap, NumberCount)
+	StringByName = make(map[string]Cap, StringCount)
+)
+
+func init() {
+	All = make([]Cap, 0, BoolCount+NumberCount+StringCount)
+	for i := range BoolCaps {
+		BoolCaps[i] = newCap(TypeBool, i, BoolNames[i], BoolLongNames[i], BoolCodes[i])
+		BoolByName[BoolNames[i]] = BoolCaps[i]
+		All = append(All, BoolCaps[i])
+	}
+	for i := range NumberCaps {
+		NumberCaps[i] = newCap(TypeNumber, i, NumberNames[i], NumberLongNames[i], NumberCodes[i])
+		NumberByName[NumberNames[i]] = NumberCaps[i]
+		All = append(All, NumberCaps[i])
+	}
+	for i := range StringCaps {
+		StringCaps[i] = newCap(TypeString, i, StringNames[i], StringLongNames[i], StringCodes[i])
+		StringByName[StringNames[i]] = StringCaps[i]
+		All = append(All, StringCaps[i])
+	}
+}
+
+// newCap returns the metadata of a capability. The constants of this
+// package are named after the long names in camel case.
+func newCap(t Type, i int, name, long, code string) Cap {
+	var b strings.Builder
+	for _, w := range strings.Split(long, "_") {
+		if w != "" {
+			b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+	return Cap{Type: t, Index: i, Name: name, LongName: long, Code: code, GoName: b.String()}
+}
@@ -0,0 +1,109 @@
+//go:build ignore
+// +build ignore
+
+// mkcaps generates names.go from the Caps file shipped in the ncurses
+// source tree (include/Caps).
+//
+// Usage:
+//
+//	go run mkcaps.go -caps /path/to/ncurses/include/Caps
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+// capability is a single line of the Caps file.
+type capability struct {
+	name    string // variable name, e.g. auto_left_margin
+	capname string // terminfo name, e.g. bw
+	code    string // termcap name, e.g. bw
+}
+
+func main() {
+	capsFile := flag.String("caps", "Caps", "path to the ncurses Caps file")
+	out := flag.String("o", "names.go", "output file")
+	flag.Parse()
+
+	f, err := os.Open(*capsFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var bools, numbers, strs []capability
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[0] == "capalias" || fields[0] == "infoalias" {
+			continue
+		}
+		c := capability{name: fields[0], capname: fields[1], code: fields[3]}
+		switch fields[2] {
+		case "bool":
+			bools = append(bools, c)
+		case "num":
+			numbers = append(numbers, c)
+		case "str":
+			strs = append(strs, c)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by mkcaps.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package caps")
+	writeTable(buf, "BoolNames", "BoolCount", "short names of the boolean capabilities", bools, short)
+	writeTable(buf, "BoolLongNames", "BoolCount", "long names of the boolean capabilities", bools, long)
+	writeTable(buf, "BoolCodes", "BoolCount", "termcap names of the boolean capabilities", bools, code)
+	writeTable(buf, "NumberNames", "NumberCount", "short names of the number capabilities", numbers, short)
+	writeTable(buf, "NumberLongNames", "NumberCount", "long names of the number capabilities", numbers, long)
+	writeTable(buf, "NumberCodes", "NumberCount", "termcap names of the number capabilities", numbers, code)
+	writeTable(buf, "StringNames", "StringCount", "short names of the string capabilities", strs, short)
+	writeTable(buf, "StringLongNames", "StringCount", "long names of the string capabilities", strs, long)
+	writeTable(buf, "StringCodes", "StringCount", "termcap names of the string capabilities", strs, code)
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(*out, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Selectors for the name written by writeTable.
+func short(c capability) string { return c.capname }
+func long(c capability) string  { return c.name }
+func code(c capability) string {
+	if c.code == "-" {
+		return ""
+	}
+	return c.code
+}
+
+// writeTable writes a name table indexed by capability.
+// The table is sized by count so that a mismatch with capabilities.go fails to compile.
+func writeTable(buf *bytes.Buffer, name, count, doc string, cs []capability, sel func(capability) string) {
+	fmt.Fprintf(buf, "\n// %s contains the %s, indexed by capability.\n", name, doc)
+	fmt.Fprintf(buf, "var %s = [%s]string{\n", name, count)
+	for _, c := range cs {
+		fmt.Fprintf(buf, "%q,\n", sel(c))
+	}
+	fmt.Fprintln(buf, "}")
+}
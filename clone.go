@@ -0,0 +1,156 @@
+package terminfo
+
+import "github.com/nhooyr/terminfo/caps"
+
+// Clone returns a deep copy of ti. Modifying the copy never affects ti, so
+// it is safe to customize entries shared through the cache of Load.
+func (ti *Terminfo) Clone() *Terminfo {
+	nti := *ti
+	nti.parms = nil
+	nti.Names = append([]string(nil), ti.Names...)
+	nti.Notes = append([]string(nil), ti.Notes...)
+	nti.Origins = append([]Origin(nil), ti.Origins...)
+	if ti.CapNotes != nil {
+		nti.CapNotes = make(map[string][]string, len(ti.CapNotes))
+		for k, v := range ti.CapNotes {
+			nti.CapNotes[k] = append([]string(nil), v...)
+		}
+	}
+	if ti.CapStates != nil {
+		nti.CapStates = make(map[string]CapState, len(ti.CapStates))
+		for k, v := range ti.CapStates {
+			nti.CapStates[k] = v
+		}
+	}
+	if ti.CapOrigins != nil {
+		nti.CapOrigins = make(map[string]int, len(ti.CapOrigins))
+		for k, v := range ti.CapOrigins {
+			nti.CapOrigins[k] = v
+		}
+	}
+	if ti.ExtBools != nil {
+		nti.ExtBools = make(map[string]bool, len(ti.ExtBools))
+		for k, v := range ti.ExtBools {
+			nti.ExtBools[k] = v
+		}
+	}
+	if ti.ExtNumbers != nil {
+		nti.ExtNumbers = make(map[string]int16, len(ti.ExtNumbers))
+		for k, v := range ti.ExtNumbers {
+			nti.ExtNumbers[k] = v
+		}
+	}
+	if ti.ExtStrings != nil {
+		nti.ExtStrings = make(map[string]string, len(ti.ExtStrings))
+		for k, v := range ti.ExtStrings {
+			nti.ExtStrings[k] = v
+		}
+	}
+	if ti.Layout != nil {
+		l := *ti.Layout
+		l.StringOffs = append([]int(nil), l.StringOffs...)
+		l.ExtStringOffs = append([]int(nil), l.ExtStringOffs...)
+		l.ExtNameOffs = append([]int(nil), l.ExtNameOffs...)
+		nti.Layout = &l
+	}
+	return &nti
+}
+
+// Merge returns a copy of base with the capabilities of overlay, standard and
+// extended, replacing those of base. For example, an overlay of user
+// overrides may force Tc or add the Ss and Se cursor shape strings.
+// Neither entry is modified.
+func Merge(base, overlay *Terminfo) *Terminfo {
+	ti := base.Clone()
+	ti.take(overlay, true)
+	return ti
+}
+
+// Use adds the capabilities of other that ti does not have to ti, like the
+// use= capability of the source format. To keep a shared entry unmodified,
+// call Use on a Clone.
+func (ti *Terminfo) Use(other *Terminfo) {
+	ti.take(other, false)
+}
+
+// take copies the capabilities present in src to ti and records their
+// origin. Capabilities ti already has or cancels are only replaced if
+// override is true, in which case the numbers and strings src cancels are
+// cancelled in ti too. Booleans cannot be removed since false is the same
+// as absent.
+func (ti *Terminfo) take(src *Terminfo, override bool) {
+	if len(ti.Origins) == 0 {
+		o := Origin{Kind: "entry"}
+		if len(ti.Names) > 0 {
+			o.Name = ti.Names[0]
+		}
+		ti.Origins = []Origin{o}
+	}
+	off := ti.addOrigins(src)
+	for i, v := range src.Bools {
+		if v && !ti.Bools[i] {
+			ti.Bools[i] = true
+			ti.setOrigin(caps.BoolNames[i], src, off)
+		}
+	}
+	for i, v := range src.Numbers {
+		n := caps.NumberNames[i]
+		switch {
+		case src.Number(i) >= 0 && (override || ti.Number(i) == NumAbsent):
+			ti.Numbers[i] = v
+			ti.setCapState(n, zeroState(v == 0))
+			ti.setOrigin(n, src, off)
+		case src.Number(i) == NumCancelled && override:
+			ti.Numbers[i] = 0
+			ti.setCapState(n, CapCancelled)
+			ti.setOrigin(n, src, off)
+		}
+	}
+	for i, v := range src.Strings {
+		n := caps.StringNames[i]
+		_, present := src.stringCap(i)
+		switch {
+		case present && (override || ti.stringAbsent(i)):
+			ti.Strings[i] = v
+			ti.setCapState(n, zeroState(v == ""))
+			ti.setOrigin(n, src, off)
+		case src.CapStates[n] == CapCancelled && override:
+			ti.Strings[i] = ""
+			ti.setCapState(n, CapCancelled)
+			ti.setOrigin(n, src, off)
+		}
+	}
+	if ti.ExtBools == nil {
+		ti.ExtBools = make(map[string]bool)
+	}
+	if ti.ExtNumbers == nil {
+		ti.ExtNumbers = make(map[string]int16)
+	}
+	if ti.ExtStrings == nil {
+		ti.ExtStrings = make(map[string]string)
+	}
+	for k, v := range src.ExtBools {
+		if _, ok := ti.ExtBools[k]; override || !ok {
+			ti.ExtBools[k] = v
+			ti.setOrigin(k, src, off)
+		}
+	}
+	for k, v := range src.ExtNumbers {
+		if _, ok := ti.ExtNumbers[k]; override || !ok {
+			ti.ExtNumbers[k] = v
+			ti.setOrigin(k, src, off)
+		}
+	}
+	for k, v := range src.ExtStrings {
+		if _, ok := ti.ExtStrings[k]; override || !ok {
+			ti.ExtStrings[k] = v
+			ti.setOrigin(k, src, off)
+		}
+	}
+}
+
+// stringAbsent reports whether ti neither has nor cancels the standard
+// string capability i.
+func (ti *Terminfo) stringAbsent(i int) bool {
+	return ti.Strings[i] == "" && ti.CapStates[caps.StringNames[i]] == CapAbsent
+}
@@ -0,0 +1,102 @@
+package terminfo
+
+import (
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestClone(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := ti.Clone()
+	c.Names[0] = "changed"
+	c.Strings[caps.Bell] = ""
+	c.ExtBools["Tc"] = true
+	c.Origins[0].Name = "changed"
+	if ti.Names[0] != "xterm" || ti.Strings[caps.Bell] == "" || ti.ExtBools["Tc"] || ti.Origins[0].Name == "changed" {
+		t.Error("modifying the clone modified the original")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	base, err := Load("tmux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	overlay := &Terminfo{
+		Names:      []string{"overrides"},
+		ExtBools:   map[string]bool{"Tc": true},
+		ExtStrings: map[string]string{"Ss": "\x1b[%p1%d q", "Se": "\x1b[2 q"},
+	}
+	overlay.Strings[caps.Bell] = "\x1b[?5h"
+	ti := Merge(base, overlay)
+	if !ti.ExtBools["Tc"] || ti.ExtStrings["Ss"] != "\x1b[%p1%d q" || ti.Strings[caps.Bell] != "\x1b[?5h" {
+		t.Error("the overlay was not applied")
+	}
+	if ti.Strings[caps.CursorAddress] != base.Strings[caps.CursorAddress] {
+		t.Error("the base capabilities were not kept")
+	}
+	if base.ExtBools["Tc"] || base.Strings[caps.Bell] == "\x1b[?5h" {
+		t.Error("Merge modified the base entry")
+	}
+	if o, _ := ti.Explain("Ss"); o != (Origin{Kind: "entry", Name: "overrides"}) {
+		t.Errorf("Ss comes from %v, want the overlay", o)
+	}
+	if o, _ := ti.Explain("cup"); o != base.Origins[0] {
+		t.Errorf("cup comes from %v, want %v", o, base.Origins[0])
+	}
+}
+
+func TestUse(t *testing.T) {
+	ti := &Terminfo{Names: []string{"custom"}}
+	ti.Strings[caps.Bell] = "\x1b[?5h"
+	vt100, err := Load("vt100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti.Use(vt100)
+	if ti.Strings[caps.Bell] != "\x1b[?5h" {
+		t.Error("Use replaced a capability the entry has")
+	}
+	if ti.Strings[caps.CursorAddress] != vt100.Strings[caps.CursorAddress] {
+		t.Error("Use did not add a missing capability")
+	}
+	if o, _ := ti.Explain("bel"); o != (Origin{Kind: "entry", Name: "custom"}) {
+		t.Errorf("bel comes from %v, want the entry itself", o)
+	}
+
+	// Cancelled capabilities are not filled in.
+	ti = &Terminfo{Names: []string{"custom"}, CapStates: map[string]CapState{"cup": CapCancelled, "cols": CapCancelled}}
+	ti.Use(vt100)
+	if _, ok := ti.CursorAddress(); ok || ti.Number(caps.Columns) != NumCancelled {
+		t.Error("Use filled in cancelled capabilities")
+	}
+}
+
+func TestMergeStates(t *testing.T) {
+	base, err := Load("vt100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	overlay := &Terminfo{Names: []string{"overrides"}, CapStates: map[string]CapState{
+		"cup":  CapCancelled,
+		"bel":  CapEmpty,
+		"cols": CapEmpty,
+	}}
+	ti := Merge(base, overlay)
+	if _, ok := ti.CursorAddress(); ok || ti.Get("cup").Present {
+		t.Error("the overlay did not cancel cup")
+	}
+	if s, ok := ti.Bell(); !ok || s != "" {
+		t.Errorf("bel = %q, %v; want present and empty", s, ok)
+	}
+	if n := ti.Number(caps.Columns); n != 0 {
+		t.Errorf("cols = %d, want present and 0", n)
+	}
+	if _, ok := base.CursorAddress(); !ok {
+		t.Error("Merge modified the base entry")
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// runCaps prints the standard capabilities, or those named in args.
+func runCaps(args []string) int {
+	list := caps.All
+	if len(args) > 0 {
+		list = nil
+		for _, name := range args {
+			found := false
+			for _, c := range caps.All {
+				if c.Name == name || c.LongName == name || c.GoName == name {
+					list = append(list, c)
+					found = true
+				}
+			}
+			if !found {
+				fmt.Fprintf(os.Stderr, "goti: unknown capability %q\n", name)
+				return 1
+			}
+		}
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTYPE\tINDEX\tTERMCAP\tVARIABLE\tCONSTANT")
+	for _, c := range list {
+		code := c.Code
+		if code == "" {
+			code = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\tcaps.%s\n", c.Name, c.Type, c.Index, code, c.LongName, c.GoName)
+	}
+	w.Flush()
+	return 0
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nhooyr/terminfo"
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// completionScripts are the shell scripts printed by goti completion.
+// They call goti __complete to list the words.
+var completionScripts = map[string]string{
+	"bash": `_goti() {
+	local cur=${COMP_WORDS[COMP_CWORD]}
+	local words
+	if [ "$COMP_CWORD" -eq 1 ]; then
+		words=$(goti __complete commands)
+	else
+		case ${COMP_WORDS[1]} in
+		lint) COMPREPLY=($(compgen -d -- "$cur")); return ;;
+		completion) words="bash zsh fish" ;;
+		*) words="$(goti __complete terms) $(goti __complete caps)" ;;
+		esac
+	fi
+	COMPREPLY=($(compgen -W "$words" -- "$cur"))
+}
+complete -F _goti goti
+`,
+	"zsh": `#compdef goti
+_goti() {
+	if (( CURRENT == 2 )); then
+		compadd -- ${(f)"$(goti __complete commands)"}
+		return
+	fi
+	case $words[2] in
+	lint) _files -/ ;;
+	completion) compadd bash zsh fish ;;
+	*) compadd -- ${(f)"$(goti __complete terms)"} ${(f)"$(goti __complete caps)"} ;;
+	esac
+}
+compdef _goti goti
+`,
+	"fish": `complete -c goti -f -n __fish_use_subcommand -a '(goti __complete commands)'
+complete -c goti -n '__fish_seen_subcommand_from lint' -a '(__fish_complete_directories)'
+complete -c goti -f -n '__fish_seen_subcommand_from completion' -a 'bash zsh fish'
+`,
+}
+
+func runCompletion(args []string) int {
+	if len(args) != 1 || completionScripts[args[0]] == "" {
+		fmt.Fprintln(os.Stderr, "usage: goti completion bash|zsh|fish")
+		return 2
+	}
+	fmt.Print(completionScripts[args[0]])
+	return 0
+}
+
+// runComplete prints the words completed by the shell scripts, one per line.
+func runComplete(args []string) int {
+	if len(args) != 1 {
+		return 2
+	}
+	var words []string
+	switch args[0] {
+	case "commands":
+		for name := range commands {
+			words = append(words, name)
+		}
+	case "terms":
+		names, err := terminfo.ListEntries()
+		if err != nil {
+			return 1
+		}
+		words = names
+	case "caps":
+		for _, c := range caps.All {
+			words = append(words, c.Name, c.LongName)
+		}
+	default:
+		return 2
+	}
+	for _, w := range words {
+		fmt.Println(w)
+	}
+	return 0
+}
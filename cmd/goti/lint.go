@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/nhooyr/terminfo"
+)
+
+// lintResult holds the problems found in a single entry.
+type lintResult struct {
+	Path     string             `json:"path"`
+	Problems []terminfo.Problem `json:"problems"`
+}
+
+func runLint(args []string) int {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	jobs := fs.Int("j", runtime.NumCPU(), "number of entries checked in parallel")
+	quiet := fs.Bool("q", false, "only print the summary")
+	jsonOut := fs.Bool("json", false, "print the entries with problems as JSON, and the summary to stderr")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: goti lint [-j n] [-q] [-json] dir...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+	paths := make(chan string)
+	results := make(chan lintResult)
+	var wg sync.WaitGroup
+	for i := 0; i < *jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range paths {
+				results <- lintFile(p)
+			}
+		}()
+	}
+	status := 0
+	go func() {
+		for _, dir := range fs.Args() {
+			err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				// Links are aliases of entries checked under their own name.
+				if fi.Mode().IsRegular() {
+					paths <- p
+				}
+				return nil
+			})
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "goti:", err)
+				status = 1
+			}
+		}
+		close(paths)
+		wg.Wait()
+		close(results)
+	}()
+	var all []lintResult
+	for r := range results {
+		all = append(all, r)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Path < all[j].Path
+	})
+	bad := []lintResult{}
+	for _, r := range all {
+		if len(r.Problems) == 0 {
+			continue
+		}
+		bad = append(bad, r)
+		if *quiet || *jsonOut {
+			continue
+		}
+		for _, p := range r.Problems {
+			fmt.Printf("%s: ", r.Path)
+			terminfo.WriteProblems(os.Stdout, []terminfo.Problem{p})
+		}
+	}
+	summary := os.Stdout
+	if *jsonOut {
+		summary = os.Stderr
+		if !*quiet {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "\t")
+			enc.Encode(bad)
+		}
+	}
+	fmt.Fprintf(summary, "%d entries checked, %d with problems\n", len(all), len(bad))
+	if len(bad) > 0 {
+		status = 1
+	}
+	return status
+}
+
+// lintFile decodes the entry at path and validates it.
+func lintFile(path string) (r lintResult) {
+	r.Path = path
+	fail := func(msg string, err error) {
+		r.Problems = append(r.Problems, terminfo.Problem{Severity: terminfo.SeverityError, Message: msg, Err: err})
+	}
+	defer func() {
+		// A malformed file must not stop the whole run.
+		if e := recover(); e != nil {
+			fail(fmt.Sprintf("decoder panic: %v", e), nil)
+		}
+	}()
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		fail("cannot read entry", err)
+		return
+	}
+	ti, err := terminfo.DecodeBytes(b)
+	if err != nil {
+		fail("cannot decode entry", err)
+		return
+	}
+	r.Problems = terminfo.Validate(ti)
+	return
+}
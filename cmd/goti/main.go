@@ -0,0 +1,67 @@
+// Command goti inspects terminfo databases.
+//
+// Usage:
+//
+//	goti <command> [arguments]
+//
+// The commands are:
+//
+//	lint		check every entry of terminfo directories
+//	caps		describe the standard capabilities
+//	sync		install entries from the latest terminfo source
+//	completion	print a shell completion script for bash, zsh or fish
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+)
+
+// command is a goti subcommand.
+type command struct {
+	run   func(args []string) int
+	short string
+}
+
+var commands = map[string]command{
+	"lint":       {runLint, "check every entry of terminfo directories"},
+	"caps":       {runCaps, "describe the standard capabilities"},
+	"sync":       {runSync, "install entries from the latest terminfo source"},
+	"completion": {runCompletion, "print a shell completion script"},
+}
+
+// hidden are the commands left out of the usage, used by the completion
+// scripts.
+var hidden = map[string]func(args []string) int{
+	"__complete": runComplete,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	if run, ok := hidden[os.Args[1]]; ok {
+		os.Exit(run(os.Args[2:]))
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "goti: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+	os.Exit(cmd.run(os.Args[2:]))
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: goti <command> [arguments]\n\ncommands:")
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "\t%s\t%s\n", name, commands[name].short)
+	}
+}
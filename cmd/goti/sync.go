@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/nhooyr/terminfo"
+)
+
+func runSync(args []string) int {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+	url := fs.String("url", terminfo.SourceURL, "`url` of the terminfo source")
+	file := fs.String("f", "", "read the source from `file` instead of downloading it")
+	out := fs.String("o", defaultDir(), "output `directory`")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: goti sync [-url url | -f file] [-o dir] name...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+	var src []byte
+	var err error
+	if *file != "" {
+		src, err = ioutil.ReadFile(*file)
+	} else {
+		src, err = terminfo.FetchSource(*url)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "goti:", err)
+		return 1
+	}
+	if err := terminfo.CompileEntriesTo(*out, bytes.NewReader(src), fs.Args()...); err != nil {
+		fmt.Fprintln(os.Stderr, "goti:", err)
+		return 1
+	}
+	for _, name := range fs.Args() {
+		fmt.Printf("installed %s in %s\n", name, *out)
+	}
+	return 0
+}
+
+// defaultDir returns the user's terminfo directory, $TERMINFO or else
+// ~/.terminfo.
+func defaultDir() string {
+	if dir := os.Getenv("TERMINFO"); dir != "" {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".terminfo"
+	}
+	return filepath.Join(home, ".terminfo")
+}
@@ -0,0 +1,89 @@
+// Command gotic compiles terminfo source files, like tic(1), without
+// depending on ncurses.
+//
+// Usage:
+//
+//	gotic [-o dir] [-c] file...
+//
+// The entries are written to dir in the layout read by terminfo.Load, with
+// a file for every name of each entry. dir defaults to $TERMINFO or else
+// ~/.terminfo. A file named - is read from the standard input. With -c, the
+// files are only checked, their entries being parsed and validated.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/nhooyr/terminfo"
+)
+
+var (
+	out   = flag.String("o", defaultDir(), "output `directory`")
+	check = flag.Bool("c", false, "only check the files")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: gotic [-o dir] [-c] file...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	status := 0
+	for _, name := range flag.Args() {
+		if err := compile(name); err != nil {
+			fmt.Fprintf(os.Stderr, "gotic: %s: %v\n", name, err)
+			status = 1
+		}
+	}
+	os.Exit(status)
+}
+
+// defaultDir returns the directory entries are written to by default.
+func defaultDir() string {
+	if dir := os.Getenv("TERMINFO"); dir != "" {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".terminfo"
+	}
+	return filepath.Join(home, ".terminfo")
+}
+
+// compile compiles or checks the named source file.
+func compile(name string) error {
+	var r io.Reader = os.Stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	if !*check {
+		return terminfo.CompileTo(*out, r)
+	}
+	tis, err := terminfo.ParseSource(r)
+	if err != nil {
+		return err
+	}
+	for _, ti := range tis {
+		for _, p := range terminfo.Validate(ti) {
+			fmt.Printf("%s: %s: ", name, ti.Names[0])
+			terminfo.WriteProblems(os.Stdout, []terminfo.Problem{p})
+		}
+		if _, err := terminfo.EncodeBytes(ti); err != nil {
+			return fmt.Errorf("%s: %v", ti.Names[0], err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Command terminfo queries terminfo entries, in the spirit of tput and
+// infocmp, without depending on ncurses.
+//
+// Usage:
+//
+//	terminfo [-T term] get <cap>
+//	terminfo [-T term] parm <cap> [args...]
+//	terminfo dump [-json] [-long] [term]
+//	terminfo diff <term1> <term2>
+//
+// The terminal defaults to $TERM. get prints string and number capabilities
+// and exits with status 1 if a boolean capability is false. parm evaluates a
+// parameterized string capability with numeric or string arguments.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/nhooyr/terminfo"
+)
+
+var term = flag.String("T", os.Getenv("TERM"), "terminal `name`")
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	args := flag.Args()[1:]
+	var err error
+	switch flag.Arg(0) {
+	case "get":
+		err = get(args)
+	case "parm":
+		err = parm(args)
+	case "dump":
+		err = dump(args)
+	case "diff":
+		err = diff(args)
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err == errFalse {
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "terminfo:", err)
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprint(os.Stderr, `usage:
+	terminfo [-T term] get <cap>
+	terminfo [-T term] parm <cap> [args...]
+	terminfo dump [-json] [-long] [term]
+	terminfo diff <term1> <term2>
+`)
+}
+
+// errFalse is returned by get for false booleans.
+var errFalse = errors.New("false")
+
+func get(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: get <cap>")
+	}
+	ti, err := terminfo.Load(*term)
+	if err != nil {
+		return err
+	}
+	switch c := ti.Get(args[0]); c.Kind {
+	case terminfo.CapString:
+		fmt.Print(c.Str)
+	case terminfo.CapNumber:
+		fmt.Println(c.Num)
+	case terminfo.CapBool:
+		if !c.Bool {
+			return errFalse
+		}
+	default:
+		return errFalse
+	}
+	return nil
+}
+
+func parm(args []string) error {
+	if len(args) < 1 {
+		return errors.New("usage: parm <cap> [args...]")
+	}
+	ti, err := terminfo.Load(*term)
+	if err != nil {
+		return err
+	}
+	s, ok := ti.GetString(args[0])
+	if !ok || s == "" {
+		return fmt.Errorf("%s: %w", args[0], terminfo.ErrCapAbsent)
+	}
+	p := make([]interface{}, len(args)-1)
+	for i, a := range args[1:] {
+		if n, err := strconv.Atoi(a); err == nil {
+			p[i] = n
+		} else {
+			p[i] = a
+		}
+	}
+	out, err := terminfo.ParmErr(s, p...)
+	if err != nil {
+		return err
+	}
+	fmt.Print(out)
+	return nil
+}
+
+func dump(args []string) error {
+	fs := flag.NewFlagSet("dump", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "write the entry as JSON")
+	long := fs.Bool("long", false, "use long capability names in JSON")
+	fs.Parse(args)
+	name := *term
+	if fs.NArg() > 0 {
+		name = fs.Arg(0)
+	}
+	ti, err := terminfo.Load(name)
+	if err != nil {
+		return err
+	}
+	if !*asJSON {
+		fmt.Print(ti.Describe())
+		return nil
+	}
+	b, err := ti.MarshalJSONOpts(terminfo.JSONOptions{LongNames: *long})
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	if err = json.Indent(&out, b, "", "  "); err != nil {
+		return err
+	}
+	fmt.Println(out.String())
+	return nil
+}
+
+func diff(args []string) error {
+	if len(args) != 2 {
+		return errors.New("usage: diff <term1> <term2>")
+	}
+	a, err := terminfo.Load(args[0])
+	if err != nil {
+		return err
+	}
+	b, err := terminfo.Load(args[1])
+	if err != nil {
+		return err
+	}
+	for _, d := range terminfo.Diff(a, b) {
+		fmt.Println(d)
+	}
+	return nil
+}
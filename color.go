@@ -0,0 +1,182 @@
+package terminfo
+
+import (
+	"io"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// DirectColor reports whether the terminal supports 24-bit colors, as
+// advertised by the RGB or Tc extended capabilities or by the setrgbf
+// and setrgbb extended strings.
+func (ti *Terminfo) DirectColor() bool {
+	_, f := ti.ExtString("setrgbf")
+	_, b := ti.ExtString("setrgbb")
+	return f || b || ti.ExtBool("Tc") || ti.directEntry()
+}
+
+// directEntry reports whether the entry describes a direct color terminal
+// with the RGB capability, in which case setaf and setab take 24-bit colors
+// except for the first 8 colors. ncurses allows RGB to be of any type.
+func (ti *Terminfo) directEntry() bool {
+	_, n := ti.ExtNumber("RGB")
+	_, s := ti.ExtString("RGB")
+	return ti.ExtBool("RGB") || n || s
+}
+
+// ColorRGB takes the red, green and blue components of a foreground and
+// background color and returns the string that sets them on a terminal
+// supporting 24-bit colors. A color with a negative component is left out.
+// Components are clamped to 255. On terminals without DirectColor, the
+// nearest color of the terminal's palette is used instead.
+func (ti *Terminfo) ColorRGB(fr, fg, fb, br, bg, bb int) (rv string) {
+	if fr >= 0 && fg >= 0 && fb >= 0 {
+		rv += ti.rgb(fr, fg, fb, caps.SetAForeground, "setrgbf", 38)
+	}
+	if br >= 0 && bg >= 0 && bb >= 0 {
+		rv += ti.rgb(br, bg, bb, caps.SetABackground, "setrgbb", 48)
+	}
+	return
+}
+
+// colorTo writes the string setting the palette color c with the string
+// capability i to w. rgbName and sgr are used to send it as a 24-bit color.
+func (ti *Terminfo) colorTo(w io.Writer, c, i int, rgbName string, sgr int) error {
+	if c < 0 {
+		return nil
+	}
+	maxColors := int(ti.Numbers[caps.MaxColors])
+	// Map bright colors to lower versions if the color table only holds 8.
+	if maxColors == 8 && c > 7 && c < 16 {
+		c -= 8
+	}
+	// Direct color entries interpret colors past 8 as 24-bit colors.
+	if (c >= maxColors || c >= 8 && ti.directEntry()) && c < 256 && ti.DirectColor() {
+		r, g, b := xtermColor(c)
+		_, err := io.WriteString(w, ti.rgb(r, g, b, i, rgbName, sgr))
+		return err
+	}
+	if c >= maxColors {
+		if c >= 256 || maxColors < 8 {
+			return nil
+		}
+		r, g, b := xtermColor(c)
+		c = nearestColor(maxColors, r, g, b)
+	}
+	return ParmTo(w, ti.Strings[i], c)
+}
+
+// rgb returns the string setting the 24-bit color r, g, b. It prefers the
+// extended string rgbName, then the string capability i of direct color
+// entries and then the ISO 8613-6 SGR sequence with the parameter sgr
+// for terminals with Tc. Otherwise the nearest palette color is set with i.
+func (ti *Terminfo) rgb(r, g, b, i int, rgbName string, sgr int) string {
+	r, g, b = clampByte(r), clampByte(g), clampByte(b)
+	if s, ok := ti.ExtString(rgbName); ok {
+		return Parm(s, r, g, b)
+	}
+	if ti.directEntry() && ti.Strings[i] != "" {
+		return ti.Parm(i, r<<16|g<<8|b)
+	}
+	if ti.ExtBool("Tc") {
+		return CSI([]int{sgr, 2, r, g, b}, "m")
+	}
+	if n := int(ti.Numbers[caps.MaxColors]); n >= 8 {
+		return ti.Parm(i, nearestColor(n, r, g, b))
+	}
+	return ""
+}
+
+// clampByte clamps c to the range of a color component.
+func clampByte(c int) int {
+	if c > 255 {
+		return 255
+	}
+	return c
+}
+
+// xtermBase is xterm's default palette of the first 16 colors.
+var xtermBase = [16][3]int{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// xtermColor returns the components of the color c of xterm's default 256
+// color palette: 16 base colors, a 6x6x6 color cube and 24 grays.
+func xtermColor(c int) (r, g, b int) {
+	switch {
+	case c < 16:
+		return xtermBase[c][0], xtermBase[c][1], xtermBase[c][2]
+	case c < 232:
+		c -= 16
+		return cubeLevel(c / 36), cubeLevel(c / 6 % 6), cubeLevel(c % 6)
+	}
+	v := 8 + (c-232)*10
+	return v, v, v
+}
+
+// xterm88Grays are the gray levels of xterm's 88 color palette.
+var xterm88Grays = [8]int{46, 92, 115, 139, 162, 185, 208, 231}
+
+// paletteColor returns the components of the color c of xterm's default
+// palette with n colors, which must be 8, 16, 88 or 256. The 88 color
+// palette has a 4x4x4 color cube and 8 grays.
+func paletteColor(n, c int) (r, g, b int) {
+	if n != 88 || c < 16 {
+		return xtermColor(c)
+	}
+	if c < 80 {
+		c -= 16
+		return cube88Level(c / 16), cube88Level(c / 4 % 4), cube88Level(c % 4)
+	}
+	v := xterm88Grays[c-80]
+	return v, v, v
+}
+
+// cube88Level returns the component value of the level n of the color cube
+// of the 88 color palette.
+func cube88Level(n int) int {
+	return [4]int{0, 139, 205, 255}[n]
+}
+
+// nearestColor returns the color of the palette of a terminal with maxColors
+// colors that is nearest to r, g, b. Terminals with more than 256 colors are
+// assumed to use the 256 color palette, and other sizes the largest palette
+// fitting in them.
+func nearestColor(maxColors, r, g, b int) int {
+	n := paletteSize(maxColors)
+	best, bestDist := 0, -1
+	for c := 0; c < n; c++ {
+		pr, pg, pb := paletteColor(n, c)
+		// Weight the components by how sensitive the eye is to them.
+		dist := 2*(pr-r)*(pr-r) + 4*(pg-g)*(pg-g) + 3*(pb-b)*(pb-b)
+		if bestDist == -1 || dist < bestDist {
+			best, bestDist = c, dist
+		}
+	}
+	return best
+}
+
+// paletteSize returns the size of the xterm palette assumed for a terminal
+// with maxColors colors: the largest of 8, 16, 88 and 256 fitting in them.
+func paletteSize(maxColors int) int {
+	switch {
+	case maxColors >= 256:
+		return 256
+	case maxColors >= 88:
+		return 88
+	case maxColors >= 16:
+		return 16
+	}
+	return 8
+}
+
+// cubeLevel returns the component value of the level n of the color cube.
+func cubeLevel(n int) int {
+	if n == 0 {
+		return 0
+	}
+	return 55 + n*40
+}
@@ -0,0 +1,87 @@
+package terminfo
+
+import (
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestColorRGB(t *testing.T) {
+	ti, err := Load("rxvt-unicode-256color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.DirectColor() {
+		t.Fatal("rxvt-unicode-256color does not advertise direct color")
+	}
+	tc := *ti
+	tc.ExtBools = map[string]bool{"Tc": true}
+	if got, want := tc.ColorRGB(1, 2, 300, 4, 5, 6), "\x1b[38;2;1;2;255m\x1b[48;2;4;5;6m"; got != want {
+		t.Errorf("Tc ColorRGB = %q, want %q", got, want)
+	}
+	rgb := tc
+	rgb.ExtStrings = map[string]string{"setrgbf": "\x1b[38:2::%p1%d:%p2%d:%p3%dm"}
+	if got, want := rgb.ColorRGB(1, 2, 3, -1, 0, 0), "\x1b[38:2::1:2:3m"; got != want {
+		t.Errorf("setrgbf ColorRGB = %q, want %q", got, want)
+	}
+}
+
+func TestColorDirect(t *testing.T) {
+	ti := &Terminfo{ExtBools: map[string]bool{"RGB": true}}
+	ti.Numbers[caps.MaxColors] = 32767
+	ti.Strings[caps.SetAForeground] = "\x1b[%?%p1%{8}%<%t3%p1%d%e38:2::%p1%{65536}%/%d:%p1%{256}%/%{255}%&%d:%p1%{255}%&%d%;m"
+	if got, want := ti.Color(1, -1), "\x1b[31m"; got != want {
+		t.Errorf("Color(1) = %q, want %q", got, want)
+	}
+	// Color 196 of the xterm palette is pure red.
+	if got, want := ti.Color(196, -1), "\x1b[38:2::255:0:0m"; got != want {
+		t.Errorf("Color(196) = %q, want %q", got, want)
+	}
+}
+
+func TestXtermColor(t *testing.T) {
+	for c, want := range map[int][3]int{
+		9:   {255, 0, 0},
+		16:  {0, 0, 0},
+		21:  {0, 0, 255},
+		231: {255, 255, 255},
+		232: {8, 8, 8},
+		255: {238, 238, 238},
+	} {
+		if r, g, b := xtermColor(c); [3]int{r, g, b} != want {
+			t.Errorf("xtermColor(%d) = %d, %d, %d, want %v", c, r, g, b, want)
+		}
+	}
+}
+
+func TestColorApprox(t *testing.T) {
+	lti, err := Load("rxvt-unicode-256color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti := *lti
+	if got, want := ti.ColorRGB(250, 10, 10, -1, -1, -1), ti.Color(9, -1); got != want {
+		t.Errorf("ColorRGB(250, 10, 10) = %q, want %q", got, want)
+	}
+	ti.Numbers[caps.MaxColors] = 16
+	if got, want := ti.Color(196, 21), ti.Color(9, 4); got != want {
+		t.Errorf("16 colors: Color(196, 21) = %q, want %q", got, want)
+	}
+	ti.Numbers[caps.MaxColors] = 8
+	if got, want := ti.Color(46, 232), ti.Color(2, 0); got != want {
+		t.Errorf("8 colors: Color(46, 232) = %q, want %q", got, want)
+	}
+	ti.Numbers[caps.MaxColors] = 0
+	if got := ti.Color(46, 232); got != "" {
+		t.Errorf("no colors: Color(46, 232) = %q, want empty", got)
+	}
+}
+
+func TestPaletteColor88(t *testing.T) {
+	if r, g, b := paletteColor(88, 79); r != 255 || g != 255 || b != 255 {
+		t.Errorf("paletteColor(88, 79) = %d, %d, %d, want white", r, g, b)
+	}
+	if c := nearestColor(88, 139, 0, 0); c != 32 {
+		t.Errorf("nearestColor(88, 139, 0, 0) = %d, want 32", c)
+	}
+}
@@ -0,0 +1,78 @@
+package terminfo
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CompileTo parses the entries in the source file src, as described by
+// ParseSource, and writes them compiled into the directory tree dir in the
+// layout read by Load: dir/x/xterm. Each name of an entry except the
+// description gets a file, hard linked to the first when possible and
+// copied otherwise. Directories are created as needed.
+func CompileTo(dir string, src io.Reader) error {
+	tis, err := ParseSource(src)
+	if err != nil {
+		return err
+	}
+	for _, ti := range tis {
+		if err := compileEntry(dir, ti); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compileEntry writes ti and its aliases into dir.
+func compileEntry(dir string, ti *Terminfo) error {
+	b, err := EncodeBytes(ti)
+	if err != nil {
+		return err
+	}
+	names := fileNames(ti)
+	if len(names) == 0 {
+		return ErrBadSource
+	}
+	first := ""
+	for _, name := range names {
+		sub := filepath.Join(dir, name[:1])
+		if err := os.MkdirAll(sub, 0755); err != nil {
+			return err
+		}
+		p := filepath.Join(sub, name)
+		// Replace entries compiled before, which may be links to others.
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if first != "" && os.Link(first, p) == nil {
+			continue
+		}
+		if err := ioutil.WriteFile(p, b, 0644); err != nil {
+			return err
+		}
+		if first == "" {
+			first = p
+		}
+	}
+	return nil
+}
+
+// fileNames returns the names of ti that are stored as files: all but the
+// description, which is the last of several names. Names that cannot be
+// file names are left out.
+func fileNames(ti *Terminfo) []string {
+	names := ti.Names
+	if len(names) > 1 {
+		names = names[:len(names)-1]
+	}
+	var fnames []string
+	for _, n := range names {
+		if n != "" && n != "." && n != ".." && !strings.ContainsAny(n, "/\\ ") {
+			fnames = append(fnames, n)
+		}
+	}
+	return fnames
+}
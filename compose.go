@@ -0,0 +1,78 @@
+package terminfo
+
+import (
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// colorStrings are the string capabilities taken from the inner entry by
+// Compose when it supports more colors.
+var colorStrings = []int{
+	caps.SetAForeground,
+	caps.SetABackground,
+	caps.OrigPair,
+	caps.OrigColors,
+}
+
+// Compose describes a terminal multiplexer such as screen or tmux (outer)
+// running inside another terminal (inner), following the conventions of the
+// ncurses "screen.xterm-256color" style entries. The result is named name.
+//
+// All capabilities are taken from the outer entry, since the multiplexer
+// interprets the output, except for the keys, which the multiplexer passes
+// through from the inner terminal, and the colors, which are taken from the
+// inner entry if it supports more of them. Entries without names are
+// described by the parts of name on each side of the first dot.
+// Neither entry is modified.
+func Compose(name string, outer, inner *Terminfo) *Terminfo {
+	oname, iname := name, name
+	if i := strings.IndexByte(name, '.'); i != -1 {
+		oname, iname = name[:i], name[i+1:]
+	}
+	ti := outer.Clone()
+	ti.Names = []string{name, entryName(outer, oname) + " running in " + entryName(inner, iname)}
+	ti.Origins = []Origin{{Kind: "compose", Name: name}}
+	ti.CapOrigins = nil
+	ooff := ti.addOrigins(outer)
+	ioff := ti.addOrigins(inner)
+	for _, c := range outer.sourceCaps() {
+		ti.setOrigin(c.name, outer, ooff)
+	}
+	for i, long := range caps.StringLongNames {
+		if strings.HasPrefix(long, "key_") && inner.Strings[i] != "" {
+			ti.Strings[i] = inner.Strings[i]
+			ti.setOrigin(caps.StringNames[i], inner, ioff)
+		}
+	}
+	if inner.Numbers[caps.MaxColors] > outer.Numbers[caps.MaxColors] {
+		ti.Numbers[caps.MaxColors] = inner.Numbers[caps.MaxColors]
+		ti.Numbers[caps.MaxPairs] = inner.Numbers[caps.MaxPairs]
+		ti.setOrigin(caps.NumberNames[caps.MaxColors], inner, ioff)
+		ti.setOrigin(caps.NumberNames[caps.MaxPairs], inner, ioff)
+		for _, i := range colorStrings {
+			if inner.Strings[i] != "" {
+				ti.Strings[i] = inner.Strings[i]
+				ti.setOrigin(caps.StringNames[i], inner, ioff)
+			}
+		}
+	}
+	// Modified keys, such as kUP5, are passed through too.
+	_, _, strs := inner.ExtNames()
+	for _, k := range strs {
+		if strings.HasPrefix(k, "k") {
+			v, _ := inner.ExtString(k)
+			ti.Set(k, Capability{Kind: CapString, Present: true, Str: v})
+			ti.setOrigin(k, inner, ioff)
+		}
+	}
+	return ti
+}
+
+// entryName returns the first name of ti, or def if it has none.
+func entryName(ti *Terminfo, def string) string {
+	if len(ti.Names) == 0 {
+		return def
+	}
+	return ti.Names[0]
+}
@@ -0,0 +1,121 @@
+package terminfo
+
+import (
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Conformance is a level of output conformance. Restricting an entry to a
+// conservative level keeps applications working through serial consoles and
+// other links that only pass a subset of what the entry claims.
+type Conformance int
+
+// These are the conformance levels.
+const (
+	// ConformFull keeps the entry as is.
+	ConformFull Conformance = iota
+	// ConformVT100 keeps the capabilities of a DEC VT100.
+	ConformVT100
+	// ConformECMA48 keeps the capabilities described by ECMA-48 control
+	// functions, without private sequences such as scrolling regions or
+	// keypad modes.
+	ConformECMA48
+)
+
+// conformBools, conformNumbers and conformStrings are the capabilities kept
+// by each conformance level. Input capabilities (key_*) are always kept as
+// they do not affect output.
+var (
+	conformBools = map[Conformance][]int{
+		ConformVT100: {
+			caps.AutoRightMargin, caps.EatNewlineGlitch, caps.MoveStandoutMode, caps.XonXoff,
+		},
+		ConformECMA48: {
+			caps.AutoRightMargin, caps.MoveStandoutMode,
+		},
+	}
+	conformNumbers = map[Conformance][]int{
+		ConformVT100: {
+			caps.Columns, caps.Lines, caps.InitTabs,
+		},
+		ConformECMA48: {
+			caps.Columns, caps.Lines, caps.InitTabs, caps.MaxColors, caps.MaxPairs,
+		},
+	}
+	conformStrings = map[Conformance][]int{
+		ConformVT100: {
+			caps.Bell, caps.CarriageReturn, caps.Tab, caps.ScrollForward, caps.ScrollReverse,
+			caps.CursorAddress, caps.CursorHome, caps.CursorUp, caps.CursorDown,
+			caps.CursorLeft, caps.CursorRight, caps.ParmUpCursor, caps.ParmDownCursor,
+			caps.ParmLeftCursor, caps.ParmRightCursor, caps.ClearScreen, caps.ClrEol,
+			caps.ClrEos, caps.ClrBol, caps.ChangeScrollRegion, caps.SaveCursor,
+			caps.RestoreCursor, caps.ClearAllTabs, caps.SetTab, caps.ExitAttributeMode,
+			caps.EnterBoldMode, caps.EnterBlinkMode, caps.EnterReverseMode,
+			caps.EnterUnderlineMode, caps.ExitUnderlineMode, caps.EnterStandoutMode,
+			caps.ExitStandoutMode, caps.SetAttributes, caps.AcsChars, caps.EnaAcs,
+			caps.EnterAltCharsetMode, caps.ExitAltCharsetMode, caps.KeypadXmit,
+			caps.KeypadLocal, caps.EnterAmMode, caps.ExitAmMode,
+		},
+		ConformECMA48: {
+			caps.Bell, caps.CarriageReturn, caps.Tab, caps.ScrollForward,
+			caps.CursorAddress, caps.CursorHome, caps.CursorUp, caps.CursorDown,
+			caps.CursorLeft, caps.CursorRight, caps.ParmUpCursor, caps.ParmDownCursor,
+			caps.ParmLeftCursor, caps.ParmRightCursor, caps.ColumnAddress, caps.RowAddress,
+			caps.ClearScreen, caps.ClrEol, caps.ClrEos, caps.ClrBol, caps.ClearAllTabs,
+			caps.SetTab, caps.InsertLine, caps.DeleteLine, caps.ParmInsertLine,
+			caps.ParmDeleteLine, caps.DeleteCharacter, caps.ParmDch, caps.ParmIch,
+			caps.EraseChars, caps.ExitAttributeMode, caps.EnterBoldMode,
+			caps.EnterBlinkMode, caps.EnterReverseMode, caps.EnterUnderlineMode,
+			caps.ExitUnderlineMode, caps.EnterStandoutMode, caps.ExitStandoutMode,
+			caps.SetAForeground, caps.SetABackground, caps.OrigPair,
+		},
+	}
+)
+
+// Conform returns a copy of ti restricted to the capabilities of the
+// conformance level, regardless of what the entry claims beyond them.
+// Extended capabilities other than keys are dropped below ConformFull.
+// Colors are limited to the 8 colors of ECMA-48.
+func (ti *Terminfo) Conform(level Conformance) *Terminfo {
+	if level == ConformFull {
+		nti := *ti
+		return &nti
+	}
+	nti := &Terminfo{
+		Names:      ti.Names,
+		ExtBools:   make(map[string]bool),
+		ExtNumbers: make(map[string]int16),
+		ExtStrings: make(map[string]string),
+		Notes:      ti.Notes,
+		CapNotes:   ti.CapNotes,
+	}
+	for _, i := range conformBools[level] {
+		nti.Bools[i] = ti.Bools[i]
+	}
+	for _, i := range conformNumbers[level] {
+		nti.Numbers[i] = ti.Numbers[i]
+	}
+	for _, i := range conformStrings[level] {
+		nti.Strings[i] = ti.Strings[i]
+	}
+	for i, name := range caps.StringLongNames {
+		if strings.HasPrefix(name, "key_") {
+			nti.Strings[i] = ti.Strings[i]
+		}
+	}
+	for k, v := range ti.ExtStrings {
+		if strings.HasPrefix(k, "k") {
+			nti.ExtStrings[k] = v
+		}
+	}
+	if level == ConformECMA48 {
+		if nti.Numbers[caps.MaxColors] > 8 {
+			nti.Numbers[caps.MaxColors] = 8
+		}
+		if nti.Numbers[caps.MaxPairs] > 64 {
+			nti.Numbers[caps.MaxPairs] = 64
+		}
+	}
+	return nti
+}
@@ -0,0 +1,34 @@
+package terminfo
+
+import (
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestConform(t *testing.T) {
+	ti, err := Load("rxvt-unicode-256color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vt := ti.Conform(ConformVT100)
+	if vt.Strings[caps.CursorAddress] != ti.Strings[caps.CursorAddress] {
+		t.Error("VT100 level dropped cup")
+	}
+	if vt.Strings[caps.SetAForeground] != "" || vt.Numbers[caps.MaxColors] != 0 {
+		t.Error("VT100 level kept colors")
+	}
+	if vt.Strings[caps.KeyUp] != ti.Strings[caps.KeyUp] {
+		t.Error("VT100 level dropped key capabilities")
+	}
+	ecma := ti.Conform(ConformECMA48)
+	if ecma.Numbers[caps.MaxColors] != 8 || ecma.Strings[caps.SetAForeground] == "" {
+		t.Error("ECMA-48 level did not keep 8 colors")
+	}
+	if ecma.Strings[caps.ChangeScrollRegion] != "" || ecma.Strings[caps.KeypadXmit] != "" {
+		t.Error("ECMA-48 level kept private sequences")
+	}
+	if len(ecma.ExtBools) != 0 {
+		t.Error("ECMA-48 level kept extended booleans")
+	}
+}
@@ -1,7 +1,10 @@
 package terminfo
 
 import (
+	"bytes"
 	"errors"
+	"io"
+	"io/ioutil"
 	"strings"
 
 	"github.com/nhooyr/terminfo/caps"
@@ -15,259 +18,360 @@ var (
 	ErrBadHeader  = errors.New("terminfo: bad header")
 )
 
+// Decode reads a compiled terminfo entry from r and decodes it.
+func Decode(r io.Reader) (*Terminfo, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeBytes(b)
+}
+
+// DecodeBytes decodes the compiled terminfo entry in b.
+// The returned Terminfo does not reference b.
+func DecodeBytes(b []byte) (*Terminfo, error) {
+	return decodeBytes(b, false)
+}
+
+// decodeBytes decodes b, taking all strings from a single copy of b if
+// zeroCopy is set. Files that cannot be decoded are decoded again without
+// padding between sections, as written by some foreign systems.
+func decodeBytes(b []byte, zeroCopy bool) (*Terminfo, error) {
+	var str string
+	if zeroCopy {
+		str = string(b)
+	}
+	d := &decoder{buf: b, str: str}
+	err := d.unmarshal()
+	if err == nil {
+		d.ti.Format.BigEndian = d.format.BigEndian
+		return d.ti, nil
+	}
+	u := &decoder{buf: b, str: str, format: Format{Unpadded: true}}
+	if u.unmarshal() == nil && u.unpadded {
+		u.ti.Format = u.format
+		return u.ti, nil
+	}
+	return nil, err
+}
+
+// Format describes a variant of the compiled format that is not the one
+// written by ncurses on little-endian systems, as found in databases
+// copied from other systems.
+type Format struct {
+	// BigEndian is whether shorts and numbers are stored big-endian.
+	BigEndian bool
+	// Unpadded is whether the sections following one of odd length lack
+	// the null byte aligning them to an even offset.
+	Unpadded bool
+}
+
+// magicFormat returns the byte order of the file starting with b from its
+// magic number. ok is false for unknown magic numbers.
+func magicFormat(b []byte) (bigEndian bool, ok bool) {
+	if len(b) < 2 {
+		return false, false
+	}
+	if littleEndian(0, b) == magic {
+		return false, true
+	}
+	ok = bigEndianShort(0, b) == magic
+	return ok, ok
+}
+
 // decoder represents the state while decoding a terminfo file.
+// All offsets are ints and every section is checked against the length of
+// the file before it is read, so corrupt files cannot cause a panic.
 type decoder struct {
-	pos            int16
-	posExtNameOffs int16 // position in the name offsets
-	h              header
-	buf            []byte
-	extStringTable []byte
-	extNameTable   []byte
-	ti             *Terminfo
+	buf      []byte
+	pos      int
+	ti       *Terminfo
+	str      string // buf as a string that strings are taken from, or empty
+	format   Format // BigEndian is detected, Unpadded is chosen
+	unpadded bool   // a section of odd length was not padded
 }
 
-// sliceNext slices the next off bytes of r.buf.
-// It also increments r.pos by off.
-func (d *decoder) sliceNext(off int16) []byte {
-	// Just use off as ppos.
-	off, d.pos = d.pos, d.pos+off
-	return d.buf[off:d.pos]
+// next returns the next n bytes of the file and advances past them.
+func (d *decoder) next(n int) ([]byte, error) {
+	if n < 0 || n > len(d.buf)-d.pos {
+		return nil, ErrSmallFile
+	}
+	b := d.buf[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
 }
 
-// evenBoundary checks if we are on an uneven word boundary.
-// If so, it will skip the next byte, which should be a null.
+// evenBoundary skips the null byte that follows a section of odd length.
+// Unpadded files only record that it is missing.
 func (d *decoder) evenBoundary() {
 	if d.pos%2 == 1 {
+		if d.format.Unpadded {
+			d.unpadded = true
+			return
+		}
 		d.pos++
 	}
 }
 
-// unmarshal unmarshals the terminfo file from f.
-// TODO what is the max entry size mean in terminfo(5)?
-func (d *decoder) unmarshal() (err error) {
-	s, hl := int16(len(d.buf)), d.h.lenBytes()
-	// Add 2 extra for the magic.
-	if s < hl+2 {
-		return ErrSmallFile
-	}
-	if littleEndian(0, d.buf) != magic {
-		return ErrBadHeader
+// short decodes a signed short starting at i in buf.
+func (d *decoder) short(i int, buf []byte) int {
+	if d.format.BigEndian {
+		return bigEndianShort(i, buf)
+	}
+	return littleEndian(i, buf)
+}
+
+// header reads a header of 5 shorts, none of which may be negative.
+func (d *decoder) header() (h header, err error) {
+	hbuf, err := d.next(len(h) * 2)
+	if err != nil {
+		return h, err
+	}
+	for i := range h {
+		if h[i] = d.short(i*2, hbuf); h[i] < 0 {
+			return h, ErrBadHeader
+		}
+	}
+	return h, nil
+}
+
+// start reads the magic number and the header of the standard capabilities.
+func (d *decoder) start() (h header, err error) {
+	if len(d.buf) < 2 {
+		return h, ErrSmallFile
+	}
+	var ok bool
+	if d.format.BigEndian, ok = magicFormat(d.buf); !ok {
+		return h, ErrBadHeader
 	}
-	// Skip magic.
 	d.pos = 2
-	if err = d.unmarshalHeader(); err != nil {
-		return err
+	if h, err = d.header(); err != nil {
+		return h, err
 	}
-	if s-d.pos < d.h.lenCaps() {
-		return ErrSmallFile
+	if h[lenBools] > caps.BoolCount || h[lenNumbers] > caps.NumberCount || h[lenStrings] > caps.StringCount {
+		return h, ErrBadHeader
 	}
-	if d.h.excessCaps() {
-		return ErrBadHeader
+	return h, nil
+}
+
+// names reads the names section of n bytes.
+func (d *decoder) names(n int) ([]string, error) {
+	nbase := d.pos
+	names, err := d.next(n)
+	if err != nil {
+		return nil, err
+	}
+	// Strip the null terminator.
+	if n := len(names); n > 0 && names[n-1] == 0 {
+		names = names[:n-1]
+	}
+	return strings.Split(d.string(nbase, names), "|"), nil
+}
+
+// unmarshal unmarshals the terminfo file in d.buf.
+func (d *decoder) unmarshal() error {
+	h, err := d.start()
+	if err != nil {
+		return err
 	}
 	d.ti = new(Terminfo)
-	d.unmarshalNames()
-	d.unmarshalBools()
+	if d.ti.Names, err = d.names(h[lenNames]); err != nil {
+		return err
+	}
+	bools, err := d.next(h[lenBools])
+	if err != nil {
+		return err
+	}
+	for i, b := range bools {
+		d.ti.Bools[i] = b == 1
+	}
 	d.evenBoundary()
-	d.unmarshalNumbers()
-	if err = d.unmarshalStrings(); err != nil || s <= d.pos {
+	nums, err := d.numbers(h[lenNumbers])
+	if err != nil {
+		return err
+	}
+	for i, n := range nums {
+		switch {
+		case n > 0:
+			d.ti.Numbers[i] = n
+		case n == 0:
+			d.ti.setCapState(caps.NumberNames[i], CapEmpty)
+		case n == NumCancelled:
+			d.ti.setCapState(caps.NumberNames[i], CapCancelled)
+		}
+	}
+	offs, err := d.next(h[lenStrings] * 2)
+	if err != nil {
+		return err
+	}
+	tbase := d.pos
+	table, err := d.next(h[lenTable])
+	if err != nil {
 		return err
 	}
-	// We have extended capabilities.
+	for i := range d.ti.Strings[:h[lenStrings]] {
+		switch off := d.short(i*2, offs); {
+		case off >= 0:
+			if d.ti.Strings[i], err = d.cString(tbase, table, off); err != nil {
+				return err
+			}
+			if d.ti.Strings[i] == "" {
+				d.ti.setCapState(caps.StringNames[i], CapEmpty)
+			}
+		case off == NumCancelled:
+			d.ti.setCapState(caps.StringNames[i], CapCancelled)
+		}
+	}
 	d.evenBoundary()
-	if s -= d.pos; s < hl {
-		return ErrSmallFile
+	if d.pos >= len(d.buf) {
+		return nil
 	}
-	if err = d.unmarshalHeader(); err != nil {
+	return d.unmarshalExt()
+}
+
+// unmarshalExt unmarshals the extended capabilities.
+func (d *decoder) unmarshalExt() error {
+	h, err := d.header()
+	if err != nil {
 		return err
 	}
-	if d.h.badLenExtOff() {
+	nbools, nnums, nstrs := h[lenExtBools], h[lenExtNumbers], h[lenExtStrings]
+	// The string table holds the present string values and the names of
+	// all capabilities.
+	if h[lenExtOff] > nbools+nnums+nstrs*2 {
 		return ErrBadHeader
 	}
-	if s-hl < d.h.lenExtCaps() {
-		return ErrSmallFile
+	bools, err := d.next(nbools)
+	if err != nil {
+		return err
 	}
-	if err = d.setExtNameTable(); err != nil {
+	d.evenBoundary()
+	nums, err := d.numbers(nnums)
+	if err != nil {
 		return err
 	}
-	if err = d.unmarshalExtBools(); err != nil {
+	offs, err := d.next(nstrs * 2)
+	if err != nil {
 		return err
 	}
-	d.evenBoundary()
-	if err = d.unmarshalExtNumbers(); err != nil {
+	nameOffs, err := d.next((nbools + nnums + nstrs) * 2)
+	if err != nil {
 		return err
 	}
-	return d.unmarshalExtStrings()
-}
-
-func (d *decoder) unmarshalNames() {
-	d.ti.Names = strings.Split(string(d.sliceNext(d.h[lenNames])), "|")
-}
-
-// unmarshalHeader unmarshals the terminfo header.
-func (d *decoder) unmarshalHeader() error {
-	hbuf := d.sliceNext(d.h.lenBytes())
-	for i := 0; i < len(d.h); i++ {
-		n := littleEndian(int16(i*2), hbuf)
-		if n < 0 {
-			return ErrBadHeader
-		}
-		d.h[i] = n
+	tbase := d.pos
+	table, err := d.next(h[lenTable])
+	if err != nil {
+		return err
 	}
-	return nil
-}
-
-// unmarshalBools unmarshals the boolean section.
-func (d *decoder) unmarshalBools() {
-	for i, b := range d.sliceNext(d.h[lenBools]) {
-		if b == 1 {
-			d.ti.Bools[i] = true
+	// The names follow the last string value.
+	values := make([]string, nstrs)
+	present := make([]bool, nstrs)
+	namesStart := 0
+	for i := range values {
+		off := d.short(i*2, offs)
+		if off < 0 {
+			continue
 		}
-	}
-}
-
-// unmarshalNumbers unmarshals the numeric section.
-func (d *decoder) unmarshalNumbers() {
-	nbuf := d.sliceNext(d.h[lenNumbers] * 2)
-	for i := int16(0); i < d.h[lenNumbers]; i++ {
-		if n := littleEndian(i*2, nbuf); n > -1 {
-			d.ti.Numbers[i] = n
+		if values[i], err = d.cString(tbase, table, off); err != nil {
+			return err
 		}
-	}
-}
-
-// unmarshalStrings unmarshals the string and string table sections.
-func (d *decoder) unmarshalStrings() error {
-	sbuf := d.sliceNext(d.h[lenStrings] * 2)
-	table := d.sliceNext(d.h[lenTable])
-	for i := int16(0); i < d.h[lenStrings]; i++ {
-		if off := littleEndian(i*2, sbuf); off > -1 {
-			end := indexNull(off, table)
-			if end == -1 {
-				return ErrBadString
-			}
-			d.ti.Strings[i] = string(table[off:end])
+		present[i] = true
+		if end := off + len(values[i]) + 1; end > namesStart {
+			namesStart = end
 		}
 	}
-	return nil
-}
-
-// setExtNameTable splits the string table into a string table and a name table.
-// This allows us to unmarshal the capabilities and their names concurrently.
-func (d *decoder) setExtNameTable() error {
-	d.posExtNameOffs = d.pos + d.h.extNameOffsOff()
-	lenExtNameOffs := (d.h[lenExtOff] - d.h[lenExtStrings]) * 2
-	// Find last string offset.
-	vpos := d.posExtNameOffs
-	var voff int16
-	for {
-		vpos -= 2
-		if vpos < d.pos {
-			return ErrBadString
-		}
-		d.h[lenExtStrings]--
-		if voff = littleEndian(vpos, d.buf); voff > -1 {
-			break
+	nameTable := table[namesStart:]
+	name := func(i int) (string, error) {
+		off := d.short(i*2, nameOffs)
+		if off < 0 {
+			return "", ErrBadString
 		}
+		return d.cString(tbase+namesStart, nameTable, off)
 	}
-	// Unmarshal the capability value.
-	d.extStringTable = d.buf[d.posExtNameOffs+lenExtNameOffs:]
-	vend := indexNull(voff, d.extStringTable)
-	if vend == -1 {
-		return ErrBadString
-	}
-	// The rest is the name table
-	d.extNameTable = d.extStringTable[vend+1:]
-	// Unmarshal the capability name.
-	koff := littleEndian(vpos+lenExtNameOffs, d.buf)
-	kend := indexNull(koff, d.extNameTable)
-	if kend == -1 {
-		return ErrBadString
-	}
-	// Now set them in the map, then truncate extStringTable and extNameTable to not include them.
-	d.ti.ExtStrings = make(map[string]string)
-	d.ti.ExtStrings[string(d.extNameTable[koff:kend])] = string(d.extStringTable[voff:vend])
-	d.extStringTable = d.extStringTable[:voff]
-	d.extNameTable = d.extNameTable[:koff]
-	return nil
-}
-
-// nextExtName returns the offset and ending of the next capability name.
-func (d *decoder) nextExtName() (off, end int16) {
-	off = littleEndian(d.posExtNameOffs, d.buf)
-	d.posExtNameOffs += 2
-	end = indexNull(off, d.extNameTable)
-	return
-}
-
-// unmarshalExtBools unmarshals the extended boolean section.
-func (d *decoder) unmarshalExtBools() error {
 	d.ti.ExtBools = make(map[string]bool)
-	for _, b := range d.sliceNext(d.h[lenExtBools]) {
-		off, end := d.nextExtName()
-		if end == -1 {
-			return ErrBadString
+	d.ti.ExtNumbers = make(map[string]int16)
+	d.ti.ExtStrings = make(map[string]string)
+	for i, b := range bools {
+		k, err := name(i)
+		if err != nil {
+			return err
 		}
 		if b == 1 {
-			d.ti.ExtBools[string(d.extNameTable[off:end])] = true
+			d.ti.ExtBools[k] = true
 		}
 	}
-	return nil
-}
-
-// unmarshalExtNumbers unmarshals the extended numeric section.
-func (d *decoder) unmarshalExtNumbers() error {
-	d.ti.ExtNumbers = make(map[string]int16)
-	nbuf := d.sliceNext(d.h[lenExtNumbers] * 2)
-	for i := int16(0); i < d.h[lenExtNumbers]; i++ {
-		off, end := d.nextExtName()
-		if end == -1 {
-			return ErrBadString
+	for i, n := range nums {
+		k, err := name(nbools + i)
+		if err != nil {
+			return err
 		}
-		if n := littleEndian(i*2, nbuf); n > -1 {
-			d.ti.ExtNumbers[string(d.extNameTable[off:end])] = n
+		if n >= 0 {
+			d.ti.ExtNumbers[k] = n
 		}
 	}
-	return nil
-}
-
-// unmarshalExtStrings unmarshals the extended string and string table sections.
-func (d *decoder) unmarshalExtStrings() error {
-	// lpos is the last position.
-	for lpos := d.pos + d.h[lenExtStrings]*2; d.pos < lpos; d.pos += 2 {
-		koff, kend := d.nextExtName()
-		if kend == -1 {
-			return ErrBadString
+	for i, v := range values {
+		k, err := name(nbools + nnums + i)
+		if err != nil {
+			return err
 		}
-		if voff := littleEndian(d.pos, d.buf); voff > -1 {
-			vend := indexNull(voff, d.extStringTable)
-			if vend == -1 {
-				return ErrBadString
-			}
-			d.ti.ExtStrings[string(d.extNameTable[koff:kend])] = string(d.extStringTable[voff:vend])
+		if present[i] {
+			d.ti.ExtStrings[k] = v
 		}
 	}
 	return nil
 }
 
-// littleEndian decodes a short starting at i in buf using little-endian byte order.
-func littleEndian(i int16, buf []byte) int16 {
-	return int16(buf[i+1])<<8 | int16(buf[i])
+// numbers reads n numbers. Absent and cancelled numbers are NumAbsent and
+// NumCancelled.
+func (d *decoder) numbers(n int) ([]int16, error) {
+	nbuf, err := d.next(n * 2)
+	if err != nil {
+		return nil, err
+	}
+	nums := make([]int16, n)
+	for i := range nums {
+		nums[i] = int16(d.short(i*2, nbuf))
+	}
+	return nums, nil
 }
 
-// indexNull returns the position of the next null byte in buf.
-// It is used to find the end of null terminated strings.
-func indexNull(off int16, buf []byte) int16 {
-	for ; buf[off] != 0; off++ {
-		if off >= int16(len(buf)) {
-			return -1
-		}
+// bigEndianShort decodes a signed short starting at i in buf using
+// big-endian byte order.
+func bigEndianShort(i int, buf []byte) int {
+	return int(int16(buf[i])<<8 | int16(buf[i+1]))
+}
+
+// littleEndian decodes a signed short starting at i in buf using
+// little-endian byte order.
+func littleEndian(i int, buf []byte) int {
+	return int(int16(buf[i+1])<<8 | int16(buf[i]))
+}
+
+// cString returns the null terminated string at off in table, which starts
+// at base in the file.
+func (d *decoder) cString(base int, table []byte, off int) (string, error) {
+	if off >= len(table) {
+		return "", ErrBadString
+	}
+	end := bytes.IndexByte(table[off:], 0)
+	if end == -1 {
+		return "", ErrBadString
 	}
-	return off
+	return d.string(base+off, table[off:off+end]), nil
+}
+
+// string returns b, which starts at base in the file, as a string. It is
+// a substring of d.str without allocating if d.str is set.
+func (d *decoder) string(base int, b []byte) string {
+	if d.str != "" {
+		return d.str[base : base+len(b)]
+	}
+	return string(b)
 }
 
 // header represents a Terminfo file's header.
 // It is only 5 shorts because we don't need to store magic.
-type header [5]int16
+type header [5]int
 
 // The magic number of terminfo files.
 const magic = 0x11a
@@ -287,56 +391,5 @@ const (
 	lenExtBools   = iota // bytes
 	lenExtNumbers        // shorts
 	lenExtStrings        // shorts
-	lenExtOff            // shorts
+	lenExtOff            // items in the string table
 )
-
-// lenCaps returns the length of all of the capabilies in bytes.
-func (h header) lenCaps() int16 {
-	return h[lenNames] +
-		h[lenBools] +
-		(h[lenNames]+h[lenBools])%2 +
-		h[lenNumbers]*2 +
-		h[lenStrings]*2 +
-		h[lenTable]
-}
-
-// lenExtCaps returns the length of all the extended capabilities in bytes.
-func (h header) lenExtCaps() int16 {
-	return h[lenExtBools] +
-		h[lenExtBools]%2 +
-		h[lenExtNumbers]*2 +
-		h[lenExtOff]*2 +
-		h[lenTable]
-}
-
-// lenBytes returns the length of the header in bytes.
-func (h header) lenBytes() int16 {
-	return int16(len(h) * 2)
-}
-
-// excessCaps returns true if there are too many capabilities and false otherwise.
-func (h header) excessCaps() bool {
-	if h[lenBools] > caps.BoolCount ||
-		h[lenNumbers] > caps.NumberCount ||
-		h[lenStrings] > caps.StringCount {
-		return true
-	}
-	return false
-}
-
-// badLenExtOff returns true if the length of the offsets is wrong and false otherwise.
-// The length of the offsets must be equal to the total number of capabilities (the name offsets)
-// and strings (the string offsets).
-func (h header) badLenExtOff() bool {
-	return h[lenExtBools]+h[lenExtNumbers]+h[lenExtStrings]*2 != h[lenExtOff]
-}
-
-// extNameOffsOff returns the offset from where the name offsets begin.
-func (h header) extNameOffsOff() int16 {
-	// The following works because
-	// r.h[lenExtOff] == r.h[lenExtBools]+r.h[lenExtNumbers]+r.h[lenExtStrings]*2.
-	// See the check in r.unmarshal.
-	return h[lenExtBools]%2 +
-		h[lenExtNumbers] +
-		h[lenExtOff]
-}
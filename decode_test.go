@@ -0,0 +1,271 @@
+package terminfo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestDecode(t *testing.T) {
+	b, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		t.Skip(err)
+	}
+	ti, err := Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Names[0] != "xterm" || ti.Strings[caps.CursorAddress] == "" {
+		t.Errorf("unexpected entry %q", ti.Names)
+	}
+	for _, n := range []int{0, 1, 12, len(b) / 2} {
+		if _, err = DecodeBytes(b[:n]); err == nil {
+			t.Errorf("decoded a file truncated to %d bytes", n)
+		}
+	}
+}
+
+// le appends the shorts to b in little-endian byte order.
+func le(b []byte, shorts ...int) []byte {
+	for _, n := range shorts {
+		b = append(b, byte(n), byte(n>>8))
+	}
+	return b
+}
+
+func TestDecodeLarge(t *testing.T) {
+	// An entry with a standard and an extended string of 30000 bytes each,
+	// past the 32767 bytes int16 offsets into the file can address.
+	long := strings.Repeat("x", 30000)
+	b := le(nil, magic, 4, 0, 0, caps.CursorAddress+1, len(long)+1)
+	b = append(b, "big\x00"...)
+	for i := 0; i < caps.CursorAddress; i++ {
+		b = le(b, -1)
+	}
+	b = le(b, 0)
+	b = append(append(b, long...), 0)
+	b = append(b, 0) // even boundary
+	b = le(b, 0, 0, 1, 2, len(long)+4)
+	b = le(b, 0, 0)
+	b = append(append(b, long...), 0)
+	b = append(b, "XX\x00"...)
+	if len(b) <= 1<<15 {
+		t.Fatalf("test entry is only %d bytes", len(b))
+	}
+	ti, err := DecodeBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Strings[caps.CursorAddress] != long || ti.ExtStrings["XX"] != long {
+		t.Error("long strings were not decoded")
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	for _, name := range []string{"x/xterm", "x/xterm-color", "a/ansi", "r/rxvt-unicode-256color", "v/vt100"} {
+		b, err := ioutil.ReadFile("/lib/terminfo/" + name)
+		if err != nil {
+			continue
+		}
+		f.Add(b)
+		f.Add(b[:len(b)/2])
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		ti, err := DecodeBytesOpts(b, DecodeOptions{KeepLayout: true})
+		if err == nil && ti.Layout == nil {
+			t.Error("Layout not kept")
+		}
+	})
+}
+
+func TestDecodeLayout(t *testing.T) {
+	b, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		t.Skip(err)
+	}
+	ti, err := DecodeBytesOpts(b, DecodeOptions{KeepLayout: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := ti.Layout
+	if l == nil {
+		t.Fatal("Layout not kept")
+	}
+	if got := string(b[l.Names.Start : l.Names.End-1]); got != strings.Join(ti.Names, "|") {
+		t.Errorf("names section holds %q", got)
+	}
+	off := l.StringOffs[caps.CursorAddress]
+	if got := string(b[l.StringTable.Start+off : l.StringTable.Start+off+len(ti.Strings[caps.CursorAddress])]); got != ti.Strings[caps.CursorAddress] {
+		t.Errorf("cup offset points at %q", got)
+	}
+	if len(l.ExtNameOffs) != len(ti.ExtBools)+len(ti.ExtNumbers)+len(ti.ExtStrings) || l.ExtTable.End != len(b) {
+		t.Errorf("unexpected extended layout %+v", l)
+	}
+	_, err = DecodeBytesOpts(b[:l.StringTable.Start+4], DecodeOptions{KeepLayout: true})
+	derr, ok := err.(*DecodeError)
+	if !ok || derr.Layout.StringTable.End != l.StringTable.Start+4 {
+		t.Errorf("truncated file: got error %v", err)
+	}
+}
+
+func TestDecodeZeroCopy(t *testing.T) {
+	b, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		t.Skip(err)
+	}
+	want, err := DecodeBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeBytesOpts(b, DecodeOptions{ZeroCopy: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Error("entry decoded with ZeroCopy differs")
+	}
+	for i := range b {
+		b[i] = 0
+	}
+	if got.Names[0] != "xterm" || got.Strings[caps.CursorAddress] != want.Strings[caps.CursorAddress] {
+		t.Error("entry decoded with ZeroCopy references the buffer")
+	}
+}
+
+func benchmarkDecode(b *testing.B, opts DecodeOptions) {
+	buf, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		b.Skip(err)
+	}
+	b.ReportAllocs()
+	var ti *Terminfo
+	for i := 0; i < b.N; i++ {
+		ti, err = DecodeBytesOpts(buf, opts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	result = ti
+}
+
+func BenchmarkDecode(b *testing.B) {
+	benchmarkDecode(b, DecodeOptions{})
+}
+
+func BenchmarkDecodeZeroCopy(b *testing.B) {
+	benchmarkDecode(b, DecodeOptions{ZeroCopy: true})
+}
+
+func TestSectionReader(t *testing.T) {
+	b, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		t.Skip(err)
+	}
+	ti, err := DecodeBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names, err := DecodeHeaderOnly(b); err != nil || !reflect.DeepEqual(names, ti.Names) {
+		t.Errorf("DecodeHeaderOnly = %q, %v", names, err)
+	}
+	if _, err := DecodeHeaderOnly(b[:14]); err == nil {
+		t.Error("decoded the names of a truncated file")
+	}
+	r, err := NewSectionReader(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range ti.Bools {
+		if r.Bool(i) != ti.Bools[i] {
+			t.Errorf("Bool(%s) = %v", caps.BoolNames[i], r.Bool(i))
+		}
+	}
+	for i := range ti.Numbers {
+		if n, ok := r.Number(i); ok != (ti.Numbers[i] > 0 || ti.CapStates[caps.NumberNames[i]] == CapEmpty) || ok && n != int(ti.Numbers[i]) {
+			t.Errorf("Number(%s) = %d, %v", caps.NumberNames[i], n, ok)
+		}
+	}
+	for i := range ti.Strings {
+		if s, _ := r.String(i); s != ti.Strings[i] {
+			t.Errorf("String(%s) = %q, want %q", caps.StringNames[i], s, ti.Strings[i])
+		}
+	}
+	if _, ok := r.String(-1); ok {
+		t.Error("String(-1) is present")
+	}
+}
+
+func BenchmarkDecodeHeaderOnly(b *testing.B) {
+	buf, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		b.Skip(err)
+	}
+	b.ReportAllocs()
+	var names []string
+	for i := 0; i < b.N; i++ {
+		names, err = DecodeHeaderOnly(buf)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	result = names
+}
+
+// swap reverses the bytes of each unit of size bytes in s of b.
+func swap(b []byte, s Section, size int) {
+	for i := s.Start; i+size <= s.End; i += size {
+		for j, k := i, i+size-1; j < k; j, k = j+1, k-1 {
+			b[j], b[k] = b[k], b[j]
+		}
+	}
+}
+
+func TestDecodeForeign(t *testing.T) {
+	b, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		t.Skip(err)
+	}
+	want, err := DecodeBytesOpts(b, DecodeOptions{KeepLayout: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := want.Layout
+	be := append([]byte(nil), b...)
+	swap(be, Section{0, 12}, 2)
+	swap(be, l.Numbers, 2)
+	swap(be, l.StringOffsets, 2)
+	swap(be, Section{l.ExtBools.Start - 10, l.ExtBools.Start}, 2)
+	swap(be, l.ExtNumbers, 2)
+	swap(be, l.ExtStringOffsets, 2)
+	swap(be, l.ExtNameOffsets, 2)
+	got, err := DecodeBytesOpts(be, DecodeOptions{KeepLayout: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Format.BigEndian || got.Format.Unpadded {
+		t.Errorf("big-endian file decoded as %+v", got.Format)
+	}
+	got.Format = Format{}
+	if !reflect.DeepEqual(got, want) {
+		t.Error("big-endian file decoded differently")
+	}
+
+	// Names of odd length followed by bools of even length, without the
+	// null byte aligning the numbers.
+	u := le(nil, magic, 5, 2, 1, 1, 3)
+	u = append(u, "ab|c\x00"...)
+	u = append(u, 1, 0)
+	u = le(u, 80, 0)
+	u = append(u, "xy\x00"...)
+	ti, err := DecodeBytes(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ti.Format.Unpadded || !ti.Bools[0] || ti.Numbers[0] != 80 || ti.Strings[0] != "xy" {
+		t.Errorf("unpadded file decoded as %+v %v %d %q", ti.Format, ti.Bools[0], ti.Numbers[0], ti.Strings[0])
+	}
+}
@@ -0,0 +1,114 @@
+package terminfo
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Describe returns ti in the source format like infocmp -1 -x, with one
+// capability per line, standard capabilities sorted by name before extended
+// ones, and the pairs of acsc sorted. Notes are left out.
+func (ti *Terminfo) Describe() string {
+	nti := *ti
+	nti.Notes, nti.CapNotes = nil, nil
+	nti.Strings[caps.AcsChars] = sortACS(ti.Strings[caps.AcsChars])
+	var b strings.Builder
+	nti.WriteSource(&b)
+	return b.String()
+}
+
+// sortACS sorts the pairs of an acsc string by their first character, as
+// infocmp does.
+func sortACS(acsc string) string {
+	if len(acsc)%2 != 0 {
+		return acsc
+	}
+	pairs := make([]string, 0, len(acsc)/2)
+	for i := 0; i < len(acsc); i += 2 {
+		pairs = append(pairs, acsc[i:i+2])
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i][0] < pairs[j][0]
+	})
+	return strings.Join(pairs, "")
+}
+
+// DiffKind is the kind of a CapDiff.
+type DiffKind int
+
+// These are the kinds of differences.
+const (
+	CapAdded   DiffKind = iota // present in b only
+	CapRemoved                 // present in a only
+	CapChanged                 // present in both with different values
+)
+
+// CapDiff is a capability that differs between two entries.
+type CapDiff struct {
+	Name string
+	Kind DiffKind
+	// A and B are the values in each entry as written in the source
+	// format, such as "#80" or "=\E[H", or "" for a boolean. They are
+	// empty if the capability is absent.
+	A, B string
+}
+
+// String returns the difference in the style of infocmp -d.
+func (d CapDiff) String() string {
+	switch d.Kind {
+	case CapAdded:
+		return "+ " + d.Name + d.B
+	case CapRemoved:
+		return "- " + d.Name + d.A
+	}
+	return "! " + d.Name + d.A + " -> " + d.Name + d.B
+}
+
+// Diff returns the capabilities added, removed or changed from a to b,
+// booleans first, then numbers and strings, each sorted by name.
+func Diff(a, b *Terminfo) []CapDiff {
+	type capKey struct {
+		sep  string
+		name string
+	}
+	index := func(ti *Terminfo) map[capKey]string {
+		m := make(map[capKey]string)
+		for _, c := range ti.sourceCaps() {
+			m[capKey{c.sep, c.name}] = c.sep + c.value
+		}
+		return m
+	}
+	am, bm := index(a), index(b)
+	var keys []capKey
+	for k := range am {
+		keys = append(keys, k)
+	}
+	for k := range bm {
+		if _, ok := am[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	order := map[string]int{"": 0, "#": 1, "=": 2}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].sep != keys[j].sep {
+			return order[keys[i].sep] < order[keys[j].sep]
+		}
+		return keys[i].name < keys[j].name
+	})
+	var diffs []CapDiff
+	for _, k := range keys {
+		av, aok := am[k]
+		bv, bok := bm[k]
+		switch {
+		case !aok:
+			diffs = append(diffs, CapDiff{Name: k.name, Kind: CapAdded, B: bv})
+		case !bok:
+			diffs = append(diffs, CapDiff{Name: k.name, Kind: CapRemoved, A: av})
+		case av != bv:
+			diffs = append(diffs, CapDiff{Name: k.name, Kind: CapChanged, A: av, B: bv})
+		}
+	}
+	return diffs
+}
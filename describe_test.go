@@ -0,0 +1,67 @@
+package terminfo
+
+import (
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestDescribe(t *testing.T) {
+	for _, name := range []string{"xterm", "vt100", "linux"} {
+		ti, err := Load(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Compare with the same file, as another infocmp on $PATH may
+		// default to another database.
+		if ti.Origins[0].Kind != "file" {
+			t.Skipf("%s is not read from a file", name)
+		}
+		file := ti.Origins[0].Name
+		dir := filepath.Dir(filepath.Dir(file))
+		out, err := exec.Command("infocmp", "-1", "-x", "-A", dir, name).Output()
+		if err != nil {
+			t.Skip("infocmp: ", err)
+		}
+		i := strings.IndexByte(string(out), '\n')
+		if !strings.HasSuffix(string(out[:i]), " "+file) {
+			t.Skipf("infocmp read %q, not %s", out[:i], file)
+		}
+		want := string(out[i+1:])
+		if got := ti.Describe(); got != want {
+			t.Errorf("%s: Describe differs from infocmp:\n%s", name, got)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nti := *ti
+	nti.Bools[caps.AutoRightMargin] = false
+	nti.Numbers[caps.Columns] = 132
+	nti.ExtStrings = make(map[string]string)
+	for k, v := range ti.ExtStrings {
+		nti.ExtStrings[k] = v
+	}
+	delete(nti.ExtStrings, "Ms")
+	nti.Strings[caps.DisStatusLine] = "ok"
+	want := []CapDiff{
+		{Name: "am", Kind: CapRemoved},
+		{Name: "cols", Kind: CapChanged, A: "#80", B: "#132"},
+		{Name: "Ms", Kind: CapRemoved, A: "=" + EscapeSource(ti.ExtStrings["Ms"])},
+		{Name: "dsl", Kind: CapAdded, B: "=ok"},
+	}
+	if got := Diff(ti, &nti); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff = %v, want %v", got, want)
+	}
+	if d := Diff(ti, ti); len(d) != 0 {
+		t.Errorf("Diff of an entry with itself = %v", d)
+	}
+}
@@ -0,0 +1,167 @@
+package terminfo
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Encode writes ti to w in the compiled format read by Decode, the legacy
+// format of tic(1) with 16-bit numbers. Extended capabilities are sorted by
+// name within each type, like tic does. Null bytes in strings are written as
+// \200 since strings are null terminated.
+func Encode(w io.Writer, ti *Terminfo) error {
+	b, err := EncodeBytes(ti)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// EncodeBytes returns ti in the compiled format, see Encode.
+func EncodeBytes(ti *Terminfo) ([]byte, error) {
+	e := new(encoder)
+	names := strings.Join(ti.Names, "|")
+	nbools := len(ti.Bools)
+	for nbools > 0 && !ti.Bools[nbools-1] {
+		nbools--
+	}
+	nnums := len(ti.Numbers)
+	for nnums > 0 && ti.Number(nnums-1) == NumAbsent {
+		nnums--
+	}
+	nstrs := len(ti.Strings)
+	for nstrs > 0 && ti.Strings[nstrs-1] == "" && ti.CapStates[caps.StringNames[nstrs-1]] == CapAbsent {
+		nstrs--
+	}
+	var offs []int
+	var table []byte
+	for i, s := range ti.Strings[:nstrs] {
+		if _, ok := ti.stringCap(i); !ok {
+			if ti.CapStates[caps.StringNames[i]] == CapCancelled {
+				offs = append(offs, NumCancelled)
+			} else {
+				offs = append(offs, NumAbsent)
+			}
+			continue
+		}
+		offs = append(offs, len(table))
+		table = appendCString(table, s)
+	}
+	if len(names)+1 > math.MaxInt16 || len(table) > math.MaxInt16 {
+		return nil, ErrBigSection
+	}
+	e.short(magic)
+	e.short(len(names) + 1)
+	e.short(nbools)
+	e.short(nnums)
+	e.short(nstrs)
+	e.short(len(table))
+	e.buf.WriteString(names)
+	e.buf.WriteByte(0)
+	for _, v := range ti.Bools[:nbools] {
+		if v {
+			e.buf.WriteByte(1)
+		} else {
+			e.buf.WriteByte(0)
+		}
+	}
+	e.evenBoundary()
+	for i := range ti.Numbers[:nnums] {
+		e.short(ti.Number(i))
+	}
+	for _, off := range offs {
+		e.short(off)
+	}
+	e.buf.Write(table)
+	if len(ti.ExtBools)+len(ti.ExtNumbers)+len(ti.ExtStrings) > 0 {
+		e.evenBoundary()
+		if err := e.ext(ti); err != nil {
+			return nil, err
+		}
+	}
+	return e.buf.Bytes(), nil
+}
+
+// encoder holds the compiled entry being written.
+type encoder struct {
+	buf bytes.Buffer
+}
+
+// short writes a little-endian short.
+func (e *encoder) short(n int) {
+	e.buf.WriteByte(byte(n))
+	e.buf.WriteByte(byte(n >> 8))
+}
+
+// evenBoundary writes a null byte if needed for the next section to start
+// at an even offset.
+func (e *encoder) evenBoundary() {
+	if e.buf.Len()%2 == 1 {
+		e.buf.WriteByte(0)
+	}
+}
+
+// ext writes the extended capabilities of ti.
+func (e *encoder) ext(ti *Terminfo) error {
+	bools := sortedKeys(ti.ExtBools)
+	nums := sortedKeys(ti.ExtNumbers)
+	strs := sortedKeys(ti.ExtStrings)
+	var offs, nameOffs []int
+	var values, names []byte
+	for _, k := range strs {
+		offs = append(offs, len(values))
+		values = appendCString(values, ti.ExtStrings[k])
+	}
+	for _, list := range [][]string{bools, nums, strs} {
+		for _, k := range list {
+			nameOffs = append(nameOffs, len(names))
+			names = appendCString(names, k)
+		}
+	}
+	if len(values)+len(names) > math.MaxInt16 {
+		return ErrBigSection
+	}
+	e.short(len(bools))
+	e.short(len(nums))
+	e.short(len(strs))
+	e.short(len(strs) + len(nameOffs))
+	e.short(len(values) + len(names))
+	for _, k := range bools {
+		if ti.ExtBools[k] {
+			e.buf.WriteByte(1)
+		} else {
+			e.buf.WriteByte(0)
+		}
+	}
+	e.evenBoundary()
+	for _, k := range nums {
+		e.short(int(ti.ExtNumbers[k]))
+	}
+	for _, off := range offs {
+		e.short(off)
+	}
+	for _, off := range nameOffs {
+		e.short(off)
+	}
+	e.buf.Write(values)
+	e.buf.Write(names)
+	return nil
+}
+
+// appendCString appends s and a null terminator to b, replacing the null
+// bytes of s with \200.
+func appendCString(b []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0 {
+			b = append(b, 0200)
+		} else {
+			b = append(b, s[i])
+		}
+	}
+	return append(b, 0)
+}
@@ -0,0 +1,76 @@
+package terminfo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestEncode(t *testing.T) {
+	for _, name := range []string{"xterm", "vt100", "linux"} {
+		ti, err := Load(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b bytes.Buffer
+		if err := Encode(&b, ti); err != nil {
+			t.Fatal(err)
+		}
+		dti, err := Decode(&b)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		dti.Origins, dti.CapOrigins = ti.Origins, ti.CapOrigins
+		if !reflect.DeepEqual(dti, ti) {
+			t.Errorf("%s: the entry changed after encoding", name)
+		}
+	}
+	ti := &Terminfo{Names: []string{"nul"}}
+	ti.Strings[caps.PadChar] = "\x00"
+	b, err := EncodeBytes(ti)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti, err = DecodeBytes(b); err != nil || ti.Strings[caps.PadChar] != "\x80" {
+		t.Errorf("null byte encoded as %q, %v", ti.Strings[caps.PadChar], err)
+	}
+}
+
+func TestCompileTo(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src := `test-base|base entry,
+	am, cols#80, bel=^G, Tc,
+test-term|test-alias|test terminal,
+	cup=\E[%i%p1%d;%p2%dH, use=test-base,
+`
+	if err := CompileTo(dir, strings.NewReader(src)); err != nil {
+		t.Fatal(err)
+	}
+	l := NewLoader(os.DirFS(dir))
+	for _, name := range []string{"test-term", "test-alias"} {
+		ti, err := l.Load(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ti.Bools[caps.AutoRightMargin] || ti.Numbers[caps.Columns] != 80 ||
+			ti.Strings[caps.CursorAddress] != "\x1b[%i%p1%d;%p2%dH" || !ti.ExtBools["Tc"] {
+			t.Errorf("%s: capabilities were not compiled", name)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "t", "test terminal")); !os.IsNotExist(err) {
+		t.Error("the description was written as a name")
+	}
+	if _, err := l.Load("test-base"); err != nil {
+		t.Error(err)
+	}
+}
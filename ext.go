@@ -0,0 +1,89 @@
+package terminfo
+
+import (
+	"errors"
+
+	"github.com/nhooyr/terminfo/extcaps"
+)
+
+// ExtBool returns the value of the extended boolean capability with the
+// name. It is false if the entry lacks it.
+//
+// The Ext accessors read the ExtBools, ExtNumbers and ExtStrings maps of ti
+// directly and are not synchronized: calling them while another goroutine
+// modifies ti, through the maps or through Set, is a data race. They are
+// only safe on a copy that is not shared with a writer. Entries returned by
+// Load, LoadEnv, the Loader and the Cache are such copies, each owned by its
+// caller; use Clone to get another before handing an entry to a goroutine
+// that changes it.
+func (ti *Terminfo) ExtBool(name string) bool {
+	return ti.ExtBools[name]
+}
+
+// ExtNumber returns the value of the extended number capability with the
+// name. ok is false if the entry lacks it.
+func (ti *Terminfo) ExtNumber(name string) (n int, ok bool) {
+	v, ok := ti.ExtNumbers[name]
+	return int(v), ok
+}
+
+// ExtString returns the value of the extended string capability with the
+// name. ok is false if the entry lacks it.
+func (ti *Terminfo) ExtString(name string) (s string, ok bool) {
+	s, ok = ti.ExtStrings[name]
+	return s, ok
+}
+
+// ExtNames returns the sorted names of the extended capabilities of each type.
+func (ti *Terminfo) ExtNames() (bools, numbers, strs []string) {
+	return sortedKeys(ti.ExtBools), sortedKeys(ti.ExtNumbers), sortedKeys(ti.ExtStrings)
+}
+
+// ErrExtAbsent is returned by ExtNumberCap and ExtStringCap for
+// capabilities the entry lacks.
+var ErrExtAbsent = errors.New("terminfo: extended capability absent")
+
+// ExtBoolCap returns the value of the extended boolean capability c, false
+// if the entry lacks it. It returns ErrCapKind if the entry has c with
+// another type.
+func (ti *Terminfo) ExtBoolCap(c extcaps.BoolCap) (bool, error) {
+	if err := ti.extKind(string(c), CapBool); err != nil {
+		return false, err
+	}
+	return ti.ExtBool(string(c)), nil
+}
+
+// ExtNumberCap returns the value of the extended number capability c, or
+// ErrExtAbsent. It returns ErrCapKind if the entry has c with another type.
+func (ti *Terminfo) ExtNumberCap(c extcaps.NumberCap) (int, error) {
+	if err := ti.extKind(string(c), CapNumber); err != nil {
+		return 0, err
+	}
+	n, ok := ti.ExtNumber(string(c))
+	if !ok {
+		return 0, ErrExtAbsent
+	}
+	return n, nil
+}
+
+// ExtStringCap returns the value of the extended string capability c, or
+// ErrExtAbsent. It returns ErrCapKind if the entry has c with another type.
+func (ti *Terminfo) ExtStringCap(c extcaps.StringCap) (string, error) {
+	if err := ti.extKind(string(c), CapString); err != nil {
+		return "", err
+	}
+	s, ok := ti.ExtString(string(c))
+	if !ok {
+		return "", ErrExtAbsent
+	}
+	return s, nil
+}
+
+// extKind returns ErrCapKind if the extended capability with the name is
+// present with a kind other than kind.
+func (ti *Terminfo) extKind(name string, kind CapKind) error {
+	if c := ti.Get(name); c.Kind != CapUnknown && c.Kind != kind {
+		return ErrCapKind
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Package extcaps holds the names of the extended capabilities documented
+// by ncurses in user_caps(5), such as Tc and kUP5, along with their types.
+//
+// The names have types telling the type of the capability, so that the
+// accessors of terminfo.Terminfo such as ExtStringCap can check them.
+package extcaps
+
+import "github.com/nhooyr/terminfo/caps"
+
+//go:generate go run mkextcaps.go -i user_caps.txt -o names.go
+
+// BoolCap is the name of an extended boolean capability.
+type BoolCap string
+
+// NumberCap is the name of an extended number capability.
+type NumberCap string
+
+// StringCap is the name of an extended string capability.
+type StringCap string
+
+// Cap describes an extended capability.
+type Cap struct {
+	Name string
+	Type caps.Type
+	// Doc describes the capability, following its name.
+	Doc string
+}
+
+// byName maps the names of All to their index.
+var byName = make(map[string]int, len(All))
+
+func init() {
+	for i, c := range All {
+		byName[c.Name] = i
+	}
+}
+
+// Lookup returns the extended capability with the name.
+func Lookup(name string) (Cap, bool) {
+	i, ok := byName[name]
+	if !ok {
+		return Cap{}, false
+	}
+	return All[i], true
+}
@@ -0,0 +1,106 @@
+//go:build ignore
+// +build ignore
+
+// mkextcaps generates names.go from user_caps.txt, which lists the
+// extended capabilities documented by ncurses.
+//
+// Usage:
+//
+//	go run mkextcaps.go [-i user_caps.txt] [-o names.go]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+// modifiers are the names of the modifiers of the keys suffixed with 3 to 7.
+var modifiers = []string{"Alt", "Shift+Alt", "Ctrl", "Shift+Ctrl", "Ctrl+Alt"}
+
+type extCap struct {
+	name, typ, doc string
+}
+
+func main() {
+	in := flag.String("i", "user_caps.txt", "input file")
+	out := flag.String("o", "names.go", "output file")
+	flag.Parse()
+
+	f, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	var ecs []extCap
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			log.Fatalf("bad line %q", line)
+		}
+		name, typ, doc := fields[0], fields[1], fields[2]
+		switch typ {
+		case "bool", "num":
+			ecs = append(ecs, extCap{name, typ, "means that " + doc})
+		case "str":
+			ecs = append(ecs, extCap{name, typ, doc})
+		case "keys":
+			ecs = append(ecs, extCap{name, "str", "is sent by the " + doc + " key with Shift"})
+			for i, mod := range modifiers {
+				ecs = append(ecs, extCap{fmt.Sprintf("%s%d", name, i+3), "str", "is sent by the " + doc + " key with " + mod})
+			}
+		default:
+			log.Fatalf("bad type %q", typ)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	goTypes := map[string]string{"bool": "BoolCap", "num": "NumberCap", "str": "StringCap"}
+	capTypes := map[string]string{"bool": "caps.TypeBool", "num": "caps.TypeNumber", "str": "caps.TypeString"}
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by mkextcaps.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package extcaps")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, `import "github.com/nhooyr/terminfo/caps"`)
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// These are the extended capabilities.")
+	fmt.Fprintln(buf, "const (")
+	for _, ec := range ecs {
+		fmt.Fprintf(buf, "// %s %s.\n", goName(ec.name), ec.doc)
+		fmt.Fprintf(buf, "%s %s = %q\n", goName(ec.name), goTypes[ec.typ], ec.name)
+	}
+	fmt.Fprintln(buf, ")")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// All lists the extended capabilities, in the order of user_caps.txt.")
+	fmt.Fprintln(buf, "var All = []Cap{")
+	for _, ec := range ecs {
+		fmt.Fprintf(buf, "{Name: %q, Type: %s, Doc: %q},\n", ec.name, capTypes[ec.typ], ec.doc)
+	}
+	fmt.Fprintln(buf, "}")
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(*out, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// goName returns the name of the constant for the capability name.
+func goName(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,83 @@
+package terminfo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// SourceURL is where the terminfo source maintained with ncurses is
+// published, compressed with gzip.
+const SourceURL = "https://invisible-island.net/datafiles/current/terminfo.src.gz"
+
+// These are the errors returned by FetchSource and CompileEntriesTo.
+var (
+	ErrFetchStatus   = errors.New("terminfo: unexpected HTTP status fetching the source")
+	ErrEntryNotFound = errors.New("terminfo: entry not found in the source")
+)
+
+// FetchSource downloads the terminfo source at url, such as SourceURL, and
+// returns it decompressed if it was compressed with gzip.
+func FetchSource(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrFetchStatus
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < 2 || b[0] != 0x1f || b[1] != 0x8b {
+		return b, nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(zr)
+}
+
+// CompileEntriesTo is like CompileTo but only writes the entries of src
+// with one of the names, aliases included. The other entries of src are
+// still parsed to resolve use= references. ErrEntryNotFound is returned,
+// before anything is written, if a name matches no entry.
+func CompileEntriesTo(dir string, src io.Reader, names ...string) error {
+	tis, err := ParseSource(src)
+	if err != nil {
+		return err
+	}
+	want := make(map[string]bool)
+	for _, name := range names {
+		want[name] = false
+	}
+	var sel []*Terminfo
+	for _, ti := range tis {
+		found := false
+		for _, n := range ti.Names {
+			if _, ok := want[n]; ok {
+				want[n], found = true, true
+			}
+		}
+		if found {
+			sel = append(sel, ti)
+		}
+	}
+	for _, ok := range want {
+		if !ok {
+			return ErrEntryNotFound
+		}
+	}
+	for _, ti := range sel {
+		if err := compileEntry(dir, ti); err != nil {
+			return err
+		}
+	}
+	return nil
+}
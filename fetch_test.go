@@ -0,0 +1,59 @@
+package terminfo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFetchAndCompileEntries(t *testing.T) {
+	src := "test-base|base entry,\n\tam, cols#80,\n" +
+		"test-term|test terminal,\n\tbel=^G, use=test-base,\n"
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	zw.Write([]byte(src))
+	zw.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/terminfo.src.gz" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(gz.Bytes())
+	}))
+	defer srv.Close()
+
+	b, err := FetchSource(srv.URL + "/terminfo.src.gz")
+	if err != nil || string(b) != src {
+		t.Fatalf("FetchSource = %q, %v", b, err)
+	}
+	if _, err := FetchSource(srv.URL + "/missing"); err != ErrFetchStatus {
+		t.Errorf("got %v, want ErrFetchStatus", err)
+	}
+
+	dir, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := CompileEntriesTo(dir, bytes.NewReader(b), "test-term", "nope"); err != ErrEntryNotFound {
+		t.Errorf("got %v, want ErrEntryNotFound", err)
+	}
+	if err := CompileEntriesTo(dir, bytes.NewReader(b), "test-term"); err != nil {
+		t.Fatal(err)
+	}
+	ti, err := NewLoader(os.DirFS(dir)).Load("test-term")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := ti.Get("cols"); c.Num != 80 {
+		t.Errorf("use= was not resolved: cols = %d", c.Num)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "t", "test-base")); !os.IsNotExist(err) {
+		t.Error("an entry that was not selected was written")
+	}
+}
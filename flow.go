@@ -0,0 +1,49 @@
+package terminfo
+
+import "github.com/nhooyr/terminfo/caps"
+
+// The default flow control characters, DC1 and DC3.
+const (
+	DefaultXon  = '\x11'
+	DefaultXoff = '\x13'
+)
+
+// FlowControl describes how a terminal throttles its output.
+type FlowControl struct {
+	// XonXoff is whether the terminal uses xon/xoff handshaking, xon.
+	// Padding that is not mandatory is left out for such terminals.
+	XonXoff bool
+	// Required is whether padding does not work and xon/xoff must be used
+	// instead, nxon. Like in ncurses, it does not affect padding.
+	Required bool
+	// Xon and Xoff are the characters the terminal sends to resume and stop
+	// output, xonc and xoffc, or DefaultXon and DefaultXoff.
+	Xon, Xoff byte
+	// Enter and Exit turn handshaking on and off, smxon and rmxon. They are
+	// empty if the terminal cannot.
+	Enter, Exit string
+	// PaddingBaud is the lowest baud rate at which padding is needed, pb.
+	PaddingBaud int
+}
+
+// FlowControl returns the flow control capabilities of the terminal.
+// The serial line must be set up to honor the characters, such as with
+// IXON in termios, if XonXoff or Required is true.
+func (ti *Terminfo) FlowControl() FlowControl {
+	fc := FlowControl{
+		XonXoff:     ti.Bools[caps.XonXoff],
+		Required:    ti.Bools[caps.NeedsXonXoff],
+		Xon:         DefaultXon,
+		Xoff:        DefaultXoff,
+		Enter:       ti.Strings[caps.EnterXonMode],
+		Exit:        ti.Strings[caps.ExitXonMode],
+		PaddingBaud: int(ti.Numbers[caps.PaddingBaudRate]),
+	}
+	if s := ti.Strings[caps.XonCharacter]; s != "" {
+		fc.Xon = s[0]
+	}
+	if s := ti.Strings[caps.XoffCharacter]; s != "" {
+		fc.Xoff = s[0]
+	}
+	return fc
+}
@@ -0,0 +1,221 @@
+package terminfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/fs"
+)
+
+// ErrBadHashedDB is returned by Load when a hashed terminfo.db is corrupt.
+var ErrBadHashedDB = errors.New("terminfo: bad hashed terminfo database")
+
+// Layout of a Berkeley DB hash database of version 4 and later, the format
+// of the terminfo.db written by ncurses configured with --enable-hashed-db.
+const (
+	bdbHashMagic   = 0x061561
+	bdbPageHeader  = 26
+	bdbMetaChksum  = 0x01
+	bdbPageHash    = 13
+	bdbPageHashOld = 2
+	bdbPageOver    = 7
+	bdbKeyData     = 1
+	bdbOffPage     = 3
+)
+
+// bdbCharKey is hashed into the metadata page of every database, so that
+// a hash function other than the default one can be told apart.
+const bdbCharKey = "%$sniglet^&\x00"
+
+// hashDB is a Berkeley DB hash database read into memory.
+type hashDB struct {
+	b        []byte
+	order    binary.ByteOrder
+	pageSize int
+	maxBucket,
+	highMask,
+	lowMask uint32
+	spares [32]uint32
+}
+
+// newHashDB reads the metadata page of the database in b. It returns
+// ErrHashedDB for databases it cannot read, such as those of other
+// versions, encrypted or checksummed ones, and those that use a hash
+// function other than the default one.
+func newHashDB(b []byte) (*hashDB, error) {
+	if len(b) < 512 {
+		return nil, ErrBadHashedDB
+	}
+	db := &hashDB{b: b}
+	switch uint32(bdbHashMagic) {
+	case binary.LittleEndian.Uint32(b[12:]):
+		db.order = binary.LittleEndian
+	case binary.BigEndian.Uint32(b[12:]):
+		db.order = binary.BigEndian
+	default:
+		return nil, ErrHashedDB
+	}
+	if v := db.order.Uint32(b[16:]); v != 8 && v != 9 || b[24] != 0 || b[26]&bdbMetaChksum != 0 {
+		return nil, ErrHashedDB
+	}
+	if db.order.Uint32(b[92:]) != bdbHash([]byte(bdbCharKey)) {
+		return nil, ErrHashedDB
+	}
+	db.pageSize = int(db.order.Uint32(b[20:]))
+	if db.pageSize < 512 || db.pageSize > 64*1024 {
+		return nil, ErrBadHashedDB
+	}
+	db.maxBucket = db.order.Uint32(b[72:])
+	db.highMask = db.order.Uint32(b[76:])
+	db.lowMask = db.order.Uint32(b[80:])
+	for i := range db.spares {
+		db.spares[i] = db.order.Uint32(b[96+4*i:])
+	}
+	return db, nil
+}
+
+// bdbHash is the default hash function of Berkeley DB.
+func bdbHash(key []byte) uint32 {
+	var h uint32
+	for _, c := range key {
+		h *= 16777619
+		h ^= uint32(c)
+	}
+	return h
+}
+
+// page returns the page numbered pgno.
+func (db *hashDB) page(pgno uint32) ([]byte, error) {
+	off := int64(pgno) * int64(db.pageSize)
+	if pgno == 0 || off+int64(db.pageSize) > int64(len(db.b)) {
+		return nil, ErrBadHashedDB
+	}
+	return db.b[off : off+int64(db.pageSize)], nil
+}
+
+// pages returns the number of pages in the database, which bounds the
+// length of any chain of pages.
+func (db *hashDB) pages() int {
+	return len(db.b) / db.pageSize
+}
+
+// get returns the data stored under key, or fs.ErrNotExist if there is none.
+func (db *hashDB) get(key []byte) ([]byte, error) {
+	bucket := bdbHash(key) & db.highMask
+	if bucket > db.maxBucket {
+		bucket &= db.lowMask
+	}
+	log2 := 0
+	for 1<<uint(log2) < bucket+1 {
+		log2++
+	}
+	pgno := bucket + db.spares[log2]
+	for n := 0; pgno != 0; n++ {
+		p, err := db.page(pgno)
+		if err != nil {
+			return nil, err
+		}
+		if n > db.pages() || p[25] != bdbPageHash && p[25] != bdbPageHashOld {
+			return nil, ErrBadHashedDB
+		}
+		entries := int(db.order.Uint16(p[20:]))
+		for i := 0; i+1 < entries; i += 2 {
+			k, err := db.item(p, i)
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(k, key) {
+				return db.item(p, i+1)
+			}
+		}
+		pgno = db.order.Uint32(p[16:])
+	}
+	return nil, fs.ErrNotExist
+}
+
+// item returns the key or data stored at index i of the hash page p,
+// following it onto overflow pages if it is stored off the page.
+func (db *hashDB) item(p []byte, i int) ([]byte, error) {
+	if bdbPageHeader+2*(i+1) > len(p) {
+		return nil, ErrBadHashedDB
+	}
+	off, end := int(db.order.Uint16(p[bdbPageHeader+2*i:])), len(p)
+	if i > 0 {
+		end = int(db.order.Uint16(p[bdbPageHeader+2*(i-1):]))
+	}
+	if off < bdbPageHeader || off >= end || end > len(p) {
+		return nil, ErrBadHashedDB
+	}
+	it := p[off:end]
+	switch it[0] {
+	case bdbKeyData:
+		return it[1:], nil
+	case bdbOffPage:
+		if len(it) < 12 {
+			return nil, ErrBadHashedDB
+		}
+		return db.overflow(db.order.Uint32(it[4:]), int(db.order.Uint32(it[8:])))
+	}
+	return nil, ErrBadHashedDB
+}
+
+// overflow returns the n bytes stored on the chain of overflow pages
+// starting at pgno.
+func (db *hashDB) overflow(pgno uint32, n int) ([]byte, error) {
+	if n > len(db.b) {
+		return nil, ErrBadHashedDB
+	}
+	b := make([]byte, 0, n)
+	for pgno != 0 && len(b) < n {
+		p, err := db.page(pgno)
+		if err != nil {
+			return nil, err
+		}
+		size := int(db.order.Uint16(p[22:]))
+		if p[25] != bdbPageOver || bdbPageHeader+size > len(p) {
+			return nil, ErrBadHashedDB
+		}
+		b = append(b, p[bdbPageHeader:bdbPageHeader+size]...)
+		pgno = db.order.Uint32(p[16:])
+	}
+	if len(b) != n {
+		return nil, ErrBadHashedDB
+	}
+	return b, nil
+}
+
+// openHashDB reads the entry with the name from the hashed terminfo.db
+// file. ncurses stores an entry under its name list, with a 0 byte before
+// the compiled entry, and each of its names with a 2 byte before the name
+// list.
+func (l *Loader) openHashDB(file, name string) (*Terminfo, error) {
+	b, err := l.readFile(file)
+	if err != nil {
+		return nil, err
+	}
+	db, err := newHashDB(b)
+	if err != nil {
+		return nil, err
+	}
+	data, err := db.get([]byte(name))
+	if err == nil && len(data) > 0 && data[0] == 2 {
+		data, err = db.get(bytes.TrimRight(data[1:], "\x00"))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 || data[0] != 0 {
+		return nil, ErrBadHashedDB
+	}
+	// Copy the entry so that its strings do not keep the database alive.
+	ti, err := l.decode(append([]byte(nil), data[1:]...), file)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range ti.Names {
+		if n == name {
+			return ti, nil
+		}
+	}
+	return nil, fs.ErrNotExist
+}
@@ -0,0 +1,317 @@
+package terminfo
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// HTMLOptions controls an HTMLEncoder.
+type HTMLOptions struct {
+	// Classes styles the text with CSS classes, such as "fg-1" and "bold",
+	// instead of inline styles, see HTMLEncoder.StyleSheet. 24-bit colors
+	// are always set inline.
+	Classes bool
+	// Foreground and Background are the CSS colors of the default colors,
+	// used for reverse video. They default to black and white.
+	Foreground, Background string
+}
+
+// htmlStyle is the graphic rendition of text.
+type htmlStyle struct {
+	fg, bg                                   Color
+	bold, dim, italic, underline, blink      bool
+	reverse, invisible, strike, anyAttribute bool
+}
+
+// HTMLEncoder converts terminal output to HTML, styling text according to
+// the SGR sequences (colors, bold, underline...) in the output. Colors are
+// limited to those the terminal can display, as described by its entry.
+// Other sequences and control characters except newlines and tabs are
+// dropped. The output should be placed in a <pre> element.
+type HTMLEncoder struct {
+	pal   Palette
+	opts  HTMLOptions
+	tok   *Tokenizer
+	cur   htmlStyle
+	open  bool // whether a span is open
+	style htmlStyle
+}
+
+// NewHTMLEncoder returns an HTMLEncoder for the output of programs using ti.
+func (ti *Terminfo) NewHTMLEncoder(opts HTMLOptions) *HTMLEncoder {
+	if opts.Foreground == "" {
+		opts.Foreground = "#000000"
+	}
+	if opts.Background == "" {
+		opts.Background = "#ffffff"
+	}
+	return &HTMLEncoder{pal: ti.Palette(), opts: opts, tok: ti.NewTokenizer()}
+}
+
+// ToHTML converts the terminal output s to HTML with an HTMLEncoder.
+func (ti *Terminfo) ToHTML(s string, opts HTMLOptions) string {
+	var b strings.Builder
+	e := ti.NewHTMLEncoder(opts)
+	e.Write(&b, []byte(s))
+	e.Close(&b)
+	return b.String()
+}
+
+// Write converts the output b to HTML written to w. An incomplete escape
+// sequence at the end of b is kept until the next Write or Close.
+func (e *HTMLEncoder) Write(w io.Writer, b []byte) error {
+	for _, tok := range e.tok.Feed(b) {
+		if err := e.Encode(w, tok); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close converts the rest of the output and closes the open element.
+func (e *HTMLEncoder) Close(w io.Writer) error {
+	for _, tok := range e.tok.Flush() {
+		if err := e.Encode(w, tok); err != nil {
+			return err
+		}
+	}
+	if e.open {
+		e.open = false
+		_, err := io.WriteString(w, "</span>")
+		return err
+	}
+	return nil
+}
+
+// Encode writes the token as HTML to w. It is a TokenFunc, so an
+// HTMLEncoder can encode a destination of a Tee.
+func (e *HTMLEncoder) Encode(w io.Writer, tok Token) error {
+	switch tok.Kind {
+	case TokenSequence:
+		if strings.HasPrefix(tok.Data, csi) && strings.HasSuffix(tok.Data, "m") {
+			e.sgr(tok.Data[len(csi) : len(tok.Data)-1])
+		} else {
+			e.capability(tok.Cap)
+		}
+		return nil
+	case TokenControl:
+		if tok.Data != "\n" && tok.Data != "\t" {
+			return nil
+		}
+	}
+	if e.style != e.cur || !e.open && e.style.anyAttribute {
+		if e.open {
+			if _, err := io.WriteString(w, "</span>"); err != nil {
+				return err
+			}
+			e.open = false
+		}
+		e.cur = e.style
+		if e.cur.anyAttribute {
+			if _, err := io.WriteString(w, e.span()); err != nil {
+				return err
+			}
+			e.open = true
+		}
+	}
+	_, err := io.WriteString(w, html.EscapeString(tok.Data))
+	return err
+}
+
+// capability applies the attribute capabilities that are not SGR sequences.
+func (e *HTMLEncoder) capability(name string) {
+	s := &e.style
+	switch name {
+	case caps.StringNames[caps.ExitAttributeMode]:
+		*s = htmlStyle{}
+	case caps.StringNames[caps.EnterBoldMode]:
+		s.bold = true
+	case caps.StringNames[caps.EnterUnderlineMode]:
+		s.underline = true
+	case caps.StringNames[caps.ExitUnderlineMode]:
+		s.underline = false
+	case caps.StringNames[caps.EnterReverseMode], caps.StringNames[caps.EnterStandoutMode]:
+		s.reverse = true
+	case caps.StringNames[caps.ExitStandoutMode]:
+		s.reverse = false
+	}
+	s.update()
+}
+
+// sgr applies the parameters of a SGR sequence.
+func (e *HTMLEncoder) sgr(params string) {
+	s := &e.style
+	ps := strings.FieldsFunc(params, func(r rune) bool { return r == ';' || r == ':' })
+	if len(ps) == 0 || params == "0" {
+		*s = htmlStyle{}
+		return
+	}
+	num := func(i int) int {
+		if i >= len(ps) {
+			return -1
+		}
+		n, err := strconv.Atoi(ps[i])
+		if err != nil {
+			return -1
+		}
+		return n
+	}
+	for i := 0; i < len(ps); i++ {
+		switch p := num(i); {
+		case p == 0:
+			*s = htmlStyle{}
+		case p == 1:
+			s.bold = true
+		case p == 2:
+			s.dim = true
+		case p == 3:
+			s.italic = true
+		case p == 4:
+			s.underline = true
+		case p == 5 || p == 6:
+			s.blink = true
+		case p == 7:
+			s.reverse = true
+		case p == 8:
+			s.invisible = true
+		case p == 9:
+			s.strike = true
+		case p == 22:
+			s.bold, s.dim = false, false
+		case p == 23:
+			s.italic = false
+		case p == 24:
+			s.underline = false
+		case p == 25:
+			s.blink = false
+		case p == 27:
+			s.reverse = false
+		case p == 28:
+			s.invisible = false
+		case p == 29:
+			s.strike = false
+		case p >= 30 && p <= 37:
+			s.fg = e.pal.Map(PaletteColor(p - 30))
+		case p >= 40 && p <= 47:
+			s.bg = e.pal.Map(PaletteColor(p - 40))
+		case p >= 90 && p <= 97:
+			s.fg = e.pal.Map(PaletteColor(p - 90 + 8))
+		case p >= 100 && p <= 107:
+			s.bg = e.pal.Map(PaletteColor(p - 100 + 8))
+		case p == 39:
+			s.fg = Color{}
+		case p == 49:
+			s.bg = Color{}
+		case p == 38 || p == 48:
+			var c Color
+			switch num(i + 1) {
+			case 5:
+				c = e.pal.Map(PaletteColor(num(i + 2)))
+				i += 2
+			case 2:
+				// The color space may be given with colons, as in 38:2::r:g:b,
+				// in which case it is dropped by FieldsFunc.
+				c = e.pal.Map(RGBColor(num(i+2), num(i+3), num(i+4)))
+				i += 4
+			}
+			if p == 38 {
+				s.fg = c
+			} else {
+				s.bg = c
+			}
+		}
+	}
+	s.update()
+}
+
+// update records whether any attribute is set.
+func (s *htmlStyle) update() {
+	// Clear the flag first so it does not take part in the comparison.
+	s.anyAttribute = false
+	s.anyAttribute = *s != htmlStyle{}
+}
+
+// css returns the CSS color of c.
+func (e *HTMLEncoder) css(c Color) string {
+	r, g, b, _ := e.pal.RGB(c)
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// span returns the opening tag of a span with the current style.
+func (e *HTMLEncoder) span() string {
+	s := e.cur
+	var classes, styles []string
+	color := func(prop, class string, c Color, def string) {
+		switch {
+		case c.Kind == ColorDefault && def != "":
+			styles = append(styles, prop+":"+def)
+		case c.Kind == ColorDefault:
+		case e.opts.Classes && c.Kind == ColorIndex:
+			classes = append(classes, class+"-"+strconv.Itoa(c.Index))
+		default:
+			styles = append(styles, prop+":"+e.css(c))
+		}
+	}
+	if s.reverse {
+		color("color", "fg", s.bg, e.opts.Background)
+		color("background-color", "bg", s.fg, e.opts.Foreground)
+	} else {
+		color("color", "fg", s.fg, "")
+		color("background-color", "bg", s.bg, "")
+	}
+	attr := func(set bool, class, style string) {
+		switch {
+		case !set:
+		case e.opts.Classes:
+			classes = append(classes, class)
+		default:
+			styles = append(styles, style)
+		}
+	}
+	attr(s.bold, "bold", "font-weight:bold")
+	attr(s.dim, "dim", "opacity:0.5")
+	attr(s.italic, "italic", "font-style:italic")
+	attr(s.underline && s.strike, "underline strike", "text-decoration:underline line-through")
+	attr(s.underline && !s.strike, "underline", "text-decoration:underline")
+	attr(s.strike && !s.underline, "strike", "text-decoration:line-through")
+	attr(s.blink, "blink", "text-decoration:blink")
+	attr(s.invisible, "invisible", "visibility:hidden")
+	var b strings.Builder
+	b.WriteString("<span")
+	if len(classes) > 0 {
+		b.WriteString(` class="` + strings.Join(classes, " ") + `"`)
+	}
+	if len(styles) > 0 {
+		b.WriteString(` style="` + strings.Join(styles, ";") + `"`)
+	}
+	b.WriteString(">")
+	return b.String()
+}
+
+// StyleSheet returns the CSS rules of the classes used with
+// HTMLOptions.Classes, for the palette of the terminal.
+func (e *HTMLEncoder) StyleSheet() string {
+	var b strings.Builder
+	n := e.pal.Colors
+	if n > 256 {
+		n = 256
+	}
+	for c := 0; c < n; c++ {
+		css := e.css(PaletteColor(c))
+		fmt.Fprintf(&b, ".fg-%d { color: %s; }\n.bg-%d { background-color: %s; }\n", c, css, c, css)
+	}
+	b.WriteString(".bold { font-weight: bold; }\n")
+	b.WriteString(".dim { opacity: 0.5; }\n")
+	b.WriteString(".italic { font-style: italic; }\n")
+	b.WriteString(".underline { text-decoration: underline; }\n")
+	b.WriteString(".strike { text-decoration: line-through; }\n")
+	b.WriteString(".underline.strike { text-decoration: underline line-through; }\n")
+	b.WriteString(".blink { text-decoration: blink; }\n")
+	b.WriteString(".invisible { visibility: hidden; }\n")
+	return b.String()
+}
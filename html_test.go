@@ -0,0 +1,46 @@
+package terminfo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToHTML(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		in   string
+		opts HTMLOptions
+		want string
+	}{
+		{"a<b\x1b[Hc", HTMLOptions{}, "a&lt;bc"},
+		{"\x1b[1;31mred\x1b[0m x\r\n", HTMLOptions{},
+			`<span style="color:#cd0000;font-weight:bold">red</span> x` + "\n"},
+		// xterm has 8 colors, so bright and 256 colors are mapped down.
+		{"\x1b[91ma\x1b[38;5;196mb", HTMLOptions{Classes: true},
+			`<span class="fg-1">ab</span>`},
+		{"\x1b[38;2;0;0;250;4mb\x1b[24m", HTMLOptions{Classes: true},
+			`<span class="fg-4 underline">b</span>`},
+		{"\x1b[7mr\x1b[27m", HTMLOptions{Foreground: "black", Background: "white"},
+			`<span style="color:white;background-color:black">r</span>`},
+	}
+	for _, tt := range tests {
+		if got := ti.ToHTML(tt.in, tt.opts); got != tt.want {
+			t.Errorf("ToHTML(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	ti, err = Load("rxvt-unicode-256color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ti.ToHTML("\x1b[38;5;196mb", HTMLOptions{}), `<span style="color:#ff0000">b</span>`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	css := ti.NewHTMLEncoder(HTMLOptions{Classes: true}).StyleSheet()
+	if !strings.Contains(css, ".bg-255 { background-color: #eeeeee; }") {
+		t.Errorf("StyleSheet is missing the 256 color palette:\n%s", css)
+	}
+}
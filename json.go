@@ -0,0 +1,122 @@
+package terminfo
+
+import (
+	"encoding/json"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// JSONOptions controls MarshalJSONOpts.
+type JSONOptions struct {
+	// LongNames keys standard capabilities by their long names, such as
+	// "cursor_address", instead of their short names, such as "cup".
+	LongNames bool
+}
+
+// jsonEntry is the JSON representation of a Terminfo.
+// String values are escaped as in the source format, see EscapeSource.
+type jsonEntry struct {
+	Names      []string            `json:"names"`
+	Bools      map[string]bool     `json:"bools,omitempty"`
+	Numbers    map[string]int16    `json:"numbers,omitempty"`
+	Strings    map[string]string   `json:"strings,omitempty"`
+	ExtBools   map[string]bool     `json:"extBools,omitempty"`
+	ExtNumbers map[string]int16    `json:"extNumbers,omitempty"`
+	ExtStrings map[string]string   `json:"extStrings,omitempty"`
+	Notes      []string            `json:"notes,omitempty"`
+	CapNotes   map[string][]string `json:"capNotes,omitempty"`
+}
+
+// MarshalJSON encodes ti as a JSON object with the capabilities keyed by
+// their short names and escape sequences written as in the source format,
+// such as "\\E[H".
+func (ti *Terminfo) MarshalJSON() ([]byte, error) {
+	return ti.MarshalJSONOpts(JSONOptions{})
+}
+
+// MarshalJSONOpts is like MarshalJSON with options.
+func (ti *Terminfo) MarshalJSONOpts(opts JSONOptions) ([]byte, error) {
+	boolNames, numberNames, stringNames := caps.BoolNames[:], caps.NumberNames[:], caps.StringNames[:]
+	if opts.LongNames {
+		boolNames, numberNames, stringNames = caps.BoolLongNames[:], caps.NumberLongNames[:], caps.StringLongNames[:]
+	}
+	e := jsonEntry{
+		Names:      ti.Names,
+		Bools:      make(map[string]bool),
+		Numbers:    make(map[string]int16),
+		Strings:    make(map[string]string),
+		ExtBools:   ti.ExtBools,
+		ExtNumbers: ti.ExtNumbers,
+		ExtStrings: make(map[string]string, len(ti.ExtStrings)),
+		Notes:      ti.Notes,
+		CapNotes:   ti.CapNotes,
+	}
+	for i, v := range ti.Bools {
+		if v {
+			e.Bools[boolNames[i]] = true
+		}
+	}
+	for i, v := range ti.Numbers {
+		if v != 0 {
+			e.Numbers[numberNames[i]] = v
+		}
+	}
+	for i, v := range ti.Strings {
+		if v != "" {
+			e.Strings[stringNames[i]] = EscapeSource(v)
+		}
+	}
+	for k, v := range ti.ExtStrings {
+		e.ExtStrings[k] = EscapeSource(v)
+	}
+	return json.Marshal(e)
+}
+
+// UnmarshalJSON decodes an entry encoded by MarshalJSON or MarshalJSONOpts.
+// Standard capabilities may be keyed by their short or long names.
+func (ti *Terminfo) UnmarshalJSON(b []byte) error {
+	var e jsonEntry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return err
+	}
+	nti := Terminfo{
+		Names:      e.Names,
+		ExtBools:   e.ExtBools,
+		ExtNumbers: e.ExtNumbers,
+		ExtStrings: make(map[string]string, len(e.ExtStrings)),
+		Notes:      e.Notes,
+		CapNotes:   e.CapNotes,
+	}
+	if nti.ExtBools == nil {
+		nti.ExtBools = make(map[string]bool)
+	}
+	if nti.ExtNumbers == nil {
+		nti.ExtNumbers = make(map[string]int16)
+	}
+	for k, v := range e.Bools {
+		i, ok := caps.LookupBool(k)
+		if !ok {
+			return ErrBadCapName
+		}
+		nti.Bools[i] = v
+	}
+	for k, v := range e.Numbers {
+		i, ok := caps.LookupNumber(k)
+		if !ok {
+			return ErrBadCapName
+		}
+		nti.Numbers[i] = v
+	}
+	for k, v := range e.Strings {
+		i, ok := caps.LookupString(k)
+		if !ok {
+			return ErrBadCapName
+		}
+		nti.Strings[i] = UnescapeSource(v)
+	}
+	for k, v := range e.ExtStrings {
+		nti.ExtStrings[k] = UnescapeSource(v)
+	}
+	*ti = nti
+	return nil
+}
@@ -0,0 +1,40 @@
+package terminfo
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, opts := range []JSONOptions{{}, {LongNames: true}} {
+		b, err := ti.MarshalJSONOpts(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `"cup":"\\E[%i%p1%d;%p2%dH"`
+		if opts.LongNames {
+			want = `"cursor_address":"\\E[%i%p1%d;%p2%dH"`
+		}
+		if !bytes.Contains(b, []byte(want)) {
+			t.Errorf("%+v: %s does not contain %s", opts, b, want)
+		}
+		nti := new(Terminfo)
+		if err = json.Unmarshal(b, nti); err != nil {
+			t.Fatal(err)
+		}
+		// Origins are not part of the encoding.
+		nti.Origins = ti.Origins
+		if !reflect.DeepEqual(nti, ti) {
+			t.Errorf("%+v: round trip mismatch", opts)
+		}
+	}
+	if err = json.Unmarshal([]byte(`{"bools":{"nope":true}}`), new(Terminfo)); err != ErrBadCapName {
+		t.Errorf("unknown capability: got %v", err)
+	}
+}
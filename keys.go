@@ -0,0 +1,241 @@
+package terminfo
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Modifier is a bitmask of the modifier keys held down with a key.
+type Modifier int
+
+// These are the modifiers.
+const (
+	ModShift Modifier = 1 << iota
+	ModAlt
+	ModCtrl
+)
+
+// KeyNone is the Key of events that do not correspond to a standard
+// key capability, such as plain characters.
+const KeyNone = -1
+
+// KeyEvent is a key press decoded by a KeyDecoder.
+type KeyEvent struct {
+	// Key is the index in the caps package of the standard key capability,
+	// such as caps.KeyUp, or KeyNone. Modified keys described by extended
+	// capabilities such as kUP5 (Ctrl+Up) are reported as the standard key
+	// with Mod set.
+	Key int
+	// Name is the name of the capability that matched, such as "kcuu1" or
+	// "kUP5". It is empty for plain characters.
+	Name string
+	// Rune is the character typed if Name is empty.
+	Rune rune
+	Mod  Modifier
+	// Mouse is the decoded report of kmous events.
+	Mouse MouseEvent
+	// Seq is the input that produced the event.
+	Seq string
+}
+
+// ErrInvalidInput is returned by KeyDecoder.Feed when the input is not
+// valid UTF-8 and does not match any key capability.
+var ErrInvalidInput = errors.New("terminfo: invalid key input")
+
+// DefaultKeyTimeout is the default KeyDecoder.Timeout.
+const DefaultKeyTimeout = 50 * time.Millisecond
+
+// KeyDecoder turns terminal input into key events using the key
+// capabilities of a Terminfo. The terminal should be in keypad transmit
+// mode (caps.KeypadXmit) for the capabilities to describe its input.
+type KeyDecoder struct {
+	// Timeout is how long a caller should wait for more input before
+	// calling Flush when Pending returns true. A lone escape byte is
+	// only reported as an escape key press once flushed.
+	Timeout time.Duration
+
+	root    *keyNode
+	buf     []byte
+	pending time.Time
+}
+
+// keyNode is a node in the trie of key sequences.
+type keyNode struct {
+	next  map[byte]*keyNode
+	event *KeyEvent
+}
+
+// extKeys maps the base names of the extended key capabilities defined by
+// xterm to their standard key capabilities. The names are followed by a
+// digit describing the modifiers, for example kUP5 is Ctrl+Up.
+var extKeys = map[string]int{
+	"kUP":  caps.KeyUp,
+	"kDN":  caps.KeyDown,
+	"kLFT": caps.KeyLeft,
+	"kRIT": caps.KeyRight,
+	"kHOM": caps.KeyHome,
+	"kEND": caps.KeyEnd,
+	"kIC":  caps.KeyIc,
+	"kDC":  caps.KeyDc,
+	"kNXT": caps.KeyNpage,
+	"kPRV": caps.KeyPpage,
+}
+
+// NewKeyDecoder returns a KeyDecoder for all key_* capabilities of ti,
+// standard and extended. If the entry has kmous, mouse reports in both the
+// X10 and SGR formats are decoded, see MouseEvent.
+func (ti *Terminfo) NewKeyDecoder() *KeyDecoder {
+	kd := &KeyDecoder{Timeout: DefaultKeyTimeout, root: new(keyNode)}
+	for i, name := range caps.StringLongNames {
+		if strings.HasPrefix(name, "key_") && ti.Strings[i] != "" {
+			kd.add(ti.Strings[i], KeyEvent{Key: i, Name: caps.StringNames[i]})
+		}
+	}
+	if ti.Strings[caps.KeyMouse] != "" {
+		// The report format depends on the modes enabled rather than on kmous.
+		name := caps.StringNames[caps.KeyMouse]
+		kd.add(sgrMouse, KeyEvent{Key: caps.KeyMouse, Name: name})
+		kd.add(x10Mouse, KeyEvent{Key: caps.KeyMouse, Name: name})
+	}
+	for name, seq := range ti.ExtStrings {
+		if len(name) < 2 || name[0] != 'k' || seq == "" {
+			continue
+		}
+		ev := KeyEvent{Key: KeyNone, Name: name}
+		if n := len(name) - 1; name[n] >= '2' && name[n] <= '8' {
+			if key, ok := extKeys[name[:n]]; ok {
+				ev.Key = key
+				ev.Mod = Modifier(name[n] - '1')
+			}
+		}
+		kd.add(seq, ev)
+	}
+	return kd
+}
+
+// add adds the sequence to the trie. Standard capabilities take precedence
+// over extended ones sharing a sequence.
+func (kd *KeyDecoder) add(seq string, ev KeyEvent) {
+	n := kd.root
+	for i := 0; i < len(seq); i++ {
+		if n.next == nil {
+			n.next = make(map[byte]*keyNode)
+		}
+		c := n.next[seq[i]]
+		if c == nil {
+			c = new(keyNode)
+			n.next[seq[i]] = c
+		}
+		n = c
+	}
+	if n.event == nil || n.event.Key == KeyNone && ev.Key != KeyNone {
+		ev.Seq = seq
+		n.event = &ev
+	}
+}
+
+// Feed decodes as many key events as possible from the buffered input and b.
+// Input that may be the start of a longer sequence is kept until more input
+// arrives or Flush is called. Invalid input is skipped and reported with
+// ErrInvalidInput after decoding the rest.
+func (kd *KeyDecoder) Feed(b []byte) ([]KeyEvent, error) {
+	kd.buf = append(kd.buf, b...)
+	evs, err := kd.decode(false)
+	if len(kd.buf) > 0 {
+		kd.pending = time.Now()
+	}
+	return evs, err
+}
+
+// Flush decodes all buffered input, treating incomplete sequences as
+// individual key presses. It should be called when no input arrived within
+// Timeout of the last Feed that left input pending.
+func (kd *KeyDecoder) Flush() ([]KeyEvent, error) {
+	return kd.decode(true)
+}
+
+// Pending reports whether input is buffered waiting for the rest of a sequence.
+func (kd *KeyDecoder) Pending() bool {
+	return len(kd.buf) > 0
+}
+
+// Deadline returns the time at which Flush should be called if no more
+// input arrives. ok is false if no input is pending.
+func (kd *KeyDecoder) Deadline() (t time.Time, ok bool) {
+	if len(kd.buf) == 0 {
+		return time.Time{}, false
+	}
+	return kd.pending.Add(kd.Timeout), true
+}
+
+// decode decodes the buffered input. If flush is false, it stops at input
+// that may be the start of a longer sequence.
+func (kd *KeyDecoder) decode(flush bool) (evs []KeyEvent, err error) {
+	for len(kd.buf) > 0 {
+		ev, n, ok := kd.match(kd.buf, flush)
+		if !ok {
+			break
+		}
+		if n == 0 {
+			// Invalid byte.
+			kd.buf = kd.buf[1:]
+			err = ErrInvalidInput
+			continue
+		}
+		evs = append(evs, ev)
+		kd.buf = kd.buf[n:]
+	}
+	if len(kd.buf) == 0 {
+		kd.buf = kd.buf[:0]
+	}
+	return evs, err
+}
+
+// match decodes the event at the start of b and returns it with its length.
+// ok is false if more input is needed. n is 0 if the first byte is invalid.
+func (kd *KeyDecoder) match(b []byte, flush bool) (ev KeyEvent, n int, ok bool) {
+	node := kd.root
+	var m *KeyEvent
+	for i := 0; i < len(b); i++ {
+		if node = node.next[b[i]]; node == nil {
+			break
+		}
+		if node.event != nil {
+			m = node.event
+		}
+	}
+	if node != nil && len(node.next) > 0 && !flush {
+		return ev, 0, false
+	}
+	if m != nil && m.Key == caps.KeyMouse {
+		return kd.mouse(b, *m, flush)
+	}
+	if m != nil {
+		return *m, len(m.Seq), true
+	}
+	if b[0] == '\x1b' && len(b) > 1 {
+		// Escape followed by a character is sent by terminals for Alt+character.
+		ev, n, ok = kd.match(b[1:], flush)
+		if ok && n > 0 {
+			ev.Mod |= ModAlt
+			ev.Seq = string(b[:n+1])
+			return ev, n + 1, true
+		}
+		if !ok {
+			return ev, 0, false
+		}
+		// The character after the escape is invalid, report the escape alone.
+	}
+	if !utf8.FullRune(b) && !flush {
+		return ev, 0, false
+	}
+	r, size := utf8.DecodeRune(b)
+	if r == utf8.RuneError && size <= 1 {
+		return ev, 0, true
+	}
+	return KeyEvent{Key: KeyNone, Rune: r, Seq: string(b[:size])}, size, true
+}
@@ -0,0 +1,58 @@
+package terminfo
+
+import (
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestKeyDecoder(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kd := ti.NewKeyDecoder()
+	in := ti.Strings[caps.KeyUp] + "aé" + ti.ExtStrings["kUP5"] + "\x1bx"
+	// Feed the input in pieces to exercise partial sequences.
+	var evs []KeyEvent
+	for i := 0; i < len(in); i += 2 {
+		end := i + 2
+		if end > len(in) {
+			end = len(in)
+		}
+		e, err := kd.Feed([]byte(in[i:end]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		evs = append(evs, e...)
+	}
+	want := []KeyEvent{
+		{Key: caps.KeyUp, Name: "kcuu1"},
+		{Key: KeyNone, Rune: 'a'},
+		{Key: KeyNone, Rune: 'é'},
+		{Key: caps.KeyUp, Name: "kUP5", Mod: ModCtrl},
+		{Key: KeyNone, Rune: 'x', Mod: ModAlt},
+	}
+	if len(evs) != len(want) {
+		t.Fatalf("got %d events %+v, want %d", len(evs), evs, len(want))
+	}
+	for i, ev := range evs {
+		ev.Seq = ""
+		if ev != want[i] {
+			t.Errorf("event %d = %+v, want %+v", i, ev, want[i])
+		}
+	}
+
+	evs, _ = kd.Feed([]byte("\x1b"))
+	if len(evs) != 0 || !kd.Pending() {
+		t.Fatalf("bare escape was not held back: %+v", evs)
+	}
+	evs, _ = kd.Flush()
+	if len(evs) != 1 || evs[0].Rune != '\x1b' || kd.Pending() {
+		t.Errorf("Flush = %+v", evs)
+	}
+
+	if _, err = kd.Feed([]byte{0xff, 'b'}); err != ErrInvalidInput {
+		t.Errorf("got %v, want ErrInvalidInput", err)
+	}
+}
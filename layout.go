@@ -0,0 +1,155 @@
+package terminfo
+
+// DecodeOptions controls DecodeBytesOpts.
+type DecodeOptions struct {
+	// KeepLayout records where each section of the file lies in
+	// Terminfo.Layout, or in the DecodeError if the file is corrupt.
+	KeepLayout bool
+	// ZeroCopy takes the names and strings of the entry from a single copy
+	// of the file instead of allocating each of them, which Load does.
+	// The file is still copied once, so the entry does not reference b, but
+	// the whole copy is kept in memory as long as any of the strings is.
+	ZeroCopy bool
+}
+
+// Section is the byte range [Start, End) of a section of a compiled entry.
+// Sections of a truncated file are cut at its end.
+type Section struct {
+	Start, End int
+}
+
+// Layout describes how a compiled entry is laid out, for diagnosing corrupt
+// files. Offsets are as stored in the file: -1 for absent and -2 for
+// cancelled capabilities.
+type Layout struct {
+	Magic  int
+	Header [5]int
+
+	Names         Section
+	Bools         Section
+	Numbers       Section
+	StringOffsets Section
+	StringTable   Section
+	StringOffs    []int
+
+	// The extended sections are empty if there are no extended capabilities.
+	ExtHeader        [5]int
+	ExtBools         Section
+	ExtNumbers       Section
+	ExtStringOffsets Section
+	ExtNameOffsets   Section
+	ExtTable         Section // the string values followed by the names
+	ExtStringOffs    []int
+	ExtNameOffs      []int
+}
+
+// DecodeError is returned by DecodeBytesOpts for a corrupt file when
+// DecodeOptions.KeepLayout is set.
+type DecodeError struct {
+	Err    error
+	Layout *Layout // as much of the layout as could be read
+}
+
+func (e *DecodeError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeBytesOpts is like DecodeBytes with options.
+func DecodeBytesOpts(b []byte, opts DecodeOptions) (*Terminfo, error) {
+	ti, err := decodeBytes(b, opts.ZeroCopy)
+	if !opts.KeepLayout {
+		return ti, err
+	}
+	f := Format{}
+	if ti != nil {
+		f = ti.Format
+	} else {
+		f.BigEndian, _ = magicFormat(b)
+	}
+	l := readLayout(b, f)
+	if err != nil {
+		return nil, &DecodeError{Err: err, Layout: l}
+	}
+	ti.Layout = l
+	return ti, nil
+}
+
+// readLayout reads the layout of the compiled entry in b, which is in the
+// format f. It does not trust the header, reading only as far as b goes.
+func readLayout(b []byte, f Format) *Layout {
+	l := new(Layout)
+	short := func(i int) int {
+		if i+2 > len(b) {
+			return 0
+		}
+		if f.BigEndian {
+			return bigEndianShort(i, b)
+		}
+		return littleEndian(i, b)
+	}
+	align := func(pos int) int {
+		if f.Unpadded {
+			return pos
+		}
+		return pos + pos%2
+	}
+	pos := 0
+	section := func(n int) Section {
+		if n < 0 {
+			n = 0
+		}
+		s := Section{pos, pos + n}
+		if s.Start > len(b) {
+			s.Start = len(b)
+		}
+		if s.End > len(b) {
+			s.End = len(b)
+		}
+		pos += n
+		return s
+	}
+	offsets := func(s Section) []int {
+		var offs []int
+		for i := s.Start; i+2 <= s.End; i += 2 {
+			offs = append(offs, short(i))
+		}
+		return offs
+	}
+	header := func(h *[5]int) {
+		for i := range h {
+			h[i] = short(pos + i*2)
+		}
+		pos += 10
+	}
+	l.Magic = short(0)
+	pos = 2
+	header(&l.Header)
+	h := l.Header
+	l.Names = section(h[lenNames])
+	l.Bools = section(h[lenBools])
+	pos = align(pos)
+	l.Numbers = section(h[lenNumbers] * 2)
+	l.StringOffsets = section(h[lenStrings] * 2)
+	l.StringTable = section(h[lenTable])
+	l.StringOffs = offsets(l.StringOffsets)
+	pos = align(pos)
+	if pos+10 > len(b) {
+		return l
+	}
+	header(&l.ExtHeader)
+	h = l.ExtHeader
+	l.ExtBools = section(h[lenExtBools])
+	pos = align(pos)
+	l.ExtNumbers = section(h[lenExtNumbers] * 2)
+	l.ExtStringOffsets = section(h[lenExtStrings] * 2)
+	l.ExtNameOffsets = section((h[lenExtBools] + h[lenExtNumbers] + h[lenExtStrings]) * 2)
+	l.ExtTable = section(h[lenTable])
+	l.ExtStringOffs = offsets(l.ExtStringOffsets)
+	l.ExtNameOffs = offsets(l.ExtNameOffsets)
+	return l
+}
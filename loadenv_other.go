@@ -0,0 +1,11 @@
+//go:build !windows
+// +build !windows
+
+package terminfo
+
+import "os"
+
+// loadEnv loads the entry named by $TERM.
+func loadEnv() (*Terminfo, error) {
+	return Load(os.Getenv("TERM"))
+}
@@ -0,0 +1,14 @@
+//go:build windows
+// +build windows
+
+package terminfo
+
+import "os"
+
+// loadEnv loads the entry named by $TERM, falling back to LoadWindows.
+func loadEnv() (*Terminfo, error) {
+	if ti, err := Load(os.Getenv("TERM")); err == nil {
+		return ti, nil
+	}
+	return LoadWindows()
+}
@@ -0,0 +1,296 @@
+package terminfo
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Returned when no name is provided to Load.
+var ErrEmptyTerm = errors.New("terminfo: empty term name")
+
+// Loader finds, decodes and caches terminfo entries stored in a directory
+// tree laid out like /usr/share/terminfo.
+type Loader struct {
+	fsys fs.FS    // nil for the operating system's file system
+	dirs []string // nil for the directories described in terminfo(5)
+
+	cache *Cache
+}
+
+// defaultLoader is the Loader used by Load.
+var defaultLoader = &Loader{cache: DefaultCache}
+
+// NewLoader returns a Loader that searches the directories of fsys in order.
+// If no directories are given, the root of fsys is searched. A directory
+// may also be a compiled entry file, which is searched for its own names.
+// For example, entries embedded in a program with embed.FS under
+// "terminfo/x/xterm" can be loaded with NewLoader(fsys, "terminfo").
+func NewLoader(fsys fs.FS, dirs ...string) *Loader {
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+	return &Loader{fsys: fsys, dirs: dirs, cache: NewCache(0)}
+}
+
+// Cache returns the cache of the Loader.
+func (l *Loader) Cache() *Cache {
+	return l.cache
+}
+
+// LoadEnv calls Load with the name as $TERM. On Windows, it falls back to
+// LoadWindows if that fails.
+func LoadEnv() (*Terminfo, error) {
+	return loadEnv()
+}
+
+// Load follows the behavior described in terminfo(5) to find correct the terminfo file
+// using the name, reads the file and then returns a Terminfo struct that describes the file.
+// The directories searched are those of SearchPath.
+//
+// If the name is a composite of screen or tmux and another terminal, such as
+// "screen.xterm-256color", and no such entry exists, the entries on each side
+// of the first dot are loaded and composed as described by Compose. As a last resort, the entry is read from $TERMCAP.
+//
+// Entries are cached, but each call returns a new copy that the caller may
+// modify without affecting other callers.
+func Load(name string) (*Terminfo, error) {
+	return defaultLoader.Load(name)
+}
+
+// Load finds the entry with the name in the directories of the Loader and
+// returns it. Composite names are handled like in the function Load.
+// Only the Loader used by the function Load consults $TERMCAP.
+func (l *Loader) Load(name string) (ti *Terminfo, err error) {
+	if name == "" {
+		return nil, ErrEmptyTerm
+	}
+	ti, ok := l.cache.Get(name)
+	if ok {
+		return
+	}
+	ti, err = l.load(name)
+	if err == nil {
+		return
+	}
+	if i := strings.IndexByte(name, '.'); i > 0 && i < len(name)-1 && multiplexers[name[:i]] {
+		outer, oerr := l.Load(name[:i])
+		if oerr != nil {
+			return nil, err
+		}
+		inner, ierr := l.Load(name[i+1:])
+		if ierr != nil {
+			return nil, err
+		}
+		ti = Compose(name, outer, inner)
+		l.cache.Add(ti, name)
+		return ti, nil
+	}
+	if l.fsys == nil {
+		if tti, terr := loadTermcap(name); terr == nil {
+			return tti, nil
+		}
+	}
+	return nil, err
+}
+
+// multiplexers are the outer entries of the composite names handled by Load.
+var multiplexers = map[string]bool{"screen": true, "tmux": true}
+
+// load searches the directories for the entry.
+func (l *Loader) load(name string) (ti *Terminfo, err error) {
+	dirs := l.dirs
+	if dirs == nil {
+		dirs = SearchPath()
+	}
+	err = fs.ErrNotExist
+	for _, dir := range dirs {
+		ti, err = l.openDir(dir, name)
+		if err == nil {
+			return
+		}
+	}
+	return nil, err
+}
+
+// readFile reads the named file from the file system of the Loader.
+func (l *Loader) readFile(name string) ([]byte, error) {
+	if l.fsys == nil {
+		return os.ReadFile(name)
+	}
+	return fs.ReadFile(l.fsys, name)
+}
+
+// ListEntries calls ListEntries on the Loader used by Load.
+func ListEntries() ([]string, error) {
+	return defaultLoader.ListEntries()
+}
+
+// ListEntries returns the sorted names of the entries in the directories
+// of the Loader. Directories that do not exist are skipped.
+func (l *Loader) ListEntries() ([]string, error) {
+	dirs := l.dirs
+	if dirs == nil {
+		dirs = SearchPath()
+	}
+	seen := make(map[string]bool)
+	for _, dir := range dirs {
+		if fi, err := l.stat(dir); err == nil && !fi.IsDir() {
+			b, err := l.readFile(dir)
+			if err != nil {
+				return nil, err
+			}
+			ti, err := DecodeBytes(b)
+			if err != nil {
+				return nil, err
+			}
+			for _, n := range ti.Names {
+				seen[n] = true
+			}
+			continue
+		}
+		subdirs, err := l.readDir(dir)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		for _, sub := range subdirs {
+			if !sub.IsDir() {
+				continue
+			}
+			entries, err := l.readDir(path.Join(dir, sub.Name()))
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range entries {
+				if !e.IsDir() {
+					seen[e.Name()] = true
+				}
+			}
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// WalkFunc is called by WalkDB for each entry with its names and a function
+// decoding it. load must not be called after WalkFunc returns.
+type WalkFunc func(names []string, load func() (*Terminfo, error)) error
+
+// WalkDB calls WalkDB on the Loader used by Load.
+func WalkDB(dir string, fn WalkFunc) error {
+	return defaultLoader.WalkDB(dir, fn)
+}
+
+// WalkDB calls fn for each entry in the directory tree dir, in lexical
+// order of the files. Only the names of an entry are decoded unless fn calls
+// load, so scanning a whole database is cheap. Entries found under several
+// files, one for each alias, are only visited once, and files that are not
+// compiled entries are skipped. If fn returns an error, WalkDB stops and
+// returns it.
+func (l *Loader) WalkDB(dir string, fn WalkFunc) error {
+	subdirs, err := l.readDir(dir)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	for _, sub := range subdirs {
+		if !sub.IsDir() {
+			continue
+		}
+		entries, err := l.readDir(path.Join(dir, sub.Name()))
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if e.IsDir() {
+				continue
+			}
+			file := path.Join(dir, sub.Name(), e.Name())
+			if err := l.walkFile(file, seen, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// walkFile calls fn for the entry in file unless it was seen.
+func (l *Loader) walkFile(file string, seen map[string]bool, fn WalkFunc) error {
+	b, err := l.readFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		// A dangling link.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	names, err := DecodeHeaderOnly(b)
+	if err != nil || seen[names[0]] {
+		return nil
+	}
+	seen[names[0]] = true
+	return fn(names, func() (*Terminfo, error) {
+		ti, err := DecodeBytes(b)
+		if err != nil {
+			return nil, err
+		}
+		ti.Origins = []Origin{{Kind: "file", Name: file}}
+		return ti, nil
+	})
+}
+
+// readDir reads the named directory from the file system of the Loader.
+func (l *Loader) readDir(name string) ([]fs.DirEntry, error) {
+	if l.fsys == nil {
+		return os.ReadDir(name)
+	}
+	return fs.ReadDir(l.fsys, name)
+}
+
+// stat returns the FileInfo of the named file from the file system of the Loader.
+func (l *Loader) stat(name string) (fs.FileInfo, error) {
+	if l.fsys == nil {
+		return os.Stat(name)
+	}
+	return fs.Stat(l.fsys, name)
+}
+
+// openFile reads the compiled entry file and returns it if it holds name.
+func (l *Loader) openFile(file, name string) (*Terminfo, error) {
+	b, err := l.readFile(file)
+	if err != nil {
+		return nil, err
+	}
+	ti, err := l.decode(b, file)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range ti.Names {
+		if n == name {
+			return ti, nil
+		}
+	}
+	return nil, fs.ErrNotExist
+}
+
+// decode decodes the compiled entry read from file and caches it under all
+// of its names. The strings of the entry share a single copy of b.
+func (l *Loader) decode(b []byte, file string) (*Terminfo, error) {
+	ti, err := decodeBytes(b, true)
+	if err != nil {
+		return nil, err
+	}
+	ti.Origins = []Origin{{Kind: "file", Name: file}}
+	l.cache.Add(ti, ti.Names...)
+	return ti, nil
+}
@@ -0,0 +1,124 @@
+package terminfo
+
+import (
+	"strings"
+)
+
+// Need is a capability needed by an application, along with the
+// capabilities it can use instead, in order of preference.
+// It is written as the names separated by '|', such as "setrgbf|setaf".
+type Need struct {
+	Cap       string
+	Fallbacks []string
+}
+
+// ParseNeed parses a Need written as names separated by '|'.
+func ParseNeed(s string) Need {
+	names := strings.Split(s, "|")
+	return Need{Cap: names[0], Fallbacks: names[1:]}
+}
+
+func (n Need) String() string {
+	return strings.Join(append([]string{n.Cap}, n.Fallbacks...), "|")
+}
+
+// MarshalText encodes the need as written by String.
+func (n Need) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText decodes a need written as by String.
+func (n *Need) UnmarshalText(b []byte) error {
+	*n = ParseNeed(string(b))
+	return nil
+}
+
+// Manifest declares the capabilities an application uses, so that it can
+// check whether a terminal suits it at startup. It can be decoded from JSON
+// such as {"required": ["cup", "setrgbf|setaf"], "optional": ["smcup"]}.
+type Manifest struct {
+	// Required capabilities must be present, or a fallback of them.
+	Required []Need `json:"required,omitempty"`
+	// Preferred capabilities should be present, but the application works
+	// without them or their fallbacks.
+	Preferred []Need `json:"preferred,omitempty"`
+	// Optional capabilities are used when present.
+	Optional []Need `json:"optional,omitempty"`
+}
+
+// Degradation is a fallback chosen by Check for a missing capability.
+type Degradation struct {
+	Cap   string `json:"cap"`
+	Using string `json:"using"`
+}
+
+// Report is the result of Check.
+type Report struct {
+	// OK is whether all required capabilities are available.
+	OK bool `json:"ok"`
+	// Missing are the required capabilities for which neither the
+	// capability nor a fallback is present.
+	Missing []string `json:"missing,omitempty"`
+	// Degraded are the fallbacks used for missing capabilities.
+	Degraded []Degradation `json:"degraded,omitempty"`
+	// Unavailable are the preferred and optional capabilities for which
+	// neither the capability nor a fallback is present.
+	Unavailable []string `json:"unavailable,omitempty"`
+	// Using maps each needed capability that is available to the
+	// capability to use for it, itself or a fallback.
+	Using map[string]string `json:"using,omitempty"`
+}
+
+// String summarizes the report on a line, such as
+// "ok, degraded setrgbf to setaf, unavailable smcup".
+func (r Report) String() string {
+	parts := []string{"ok"}
+	if !r.OK {
+		parts[0] = "missing " + strings.Join(r.Missing, " ")
+	}
+	for _, d := range r.Degraded {
+		parts = append(parts, "degraded "+d.Cap+" to "+d.Using)
+	}
+	if len(r.Unavailable) > 0 {
+		parts = append(parts, "unavailable "+strings.Join(r.Unavailable, " "))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Check checks the capabilities of ti against the manifest, choosing the
+// first available fallback of each missing capability. Capabilities are
+// looked up as by Get; booleans must be true to be available.
+func Check(ti *Terminfo, m Manifest) Report {
+	r := Report{OK: true, Using: make(map[string]string)}
+	check := func(needs []Need, required bool) {
+		for _, n := range needs {
+			use, ok := ti.choose(n)
+			switch {
+			case ok:
+				r.Using[n.Cap] = use
+				if use != n.Cap {
+					r.Degraded = append(r.Degraded, Degradation{Cap: n.Cap, Using: use})
+				}
+			case required:
+				r.OK = false
+				r.Missing = append(r.Missing, n.Cap)
+			default:
+				r.Unavailable = append(r.Unavailable, n.Cap)
+			}
+		}
+	}
+	check(m.Required, true)
+	check(m.Preferred, false)
+	check(m.Optional, false)
+	return r
+}
+
+// choose returns the first available capability among n and its fallbacks.
+func (ti *Terminfo) choose(n Need) (string, bool) {
+	for _, name := range append([]string{n.Cap}, n.Fallbacks...) {
+		if c := ti.Get(name); c.Present && (c.Kind != CapBool || c.Bool) {
+			return name, true
+		}
+	}
+	return "", false
+}
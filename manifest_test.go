@@ -0,0 +1,41 @@
+package terminfo
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	var m Manifest
+	err := json.Unmarshal([]byte(`{
+		"required": ["cup", "setrgbf|setaf"],
+		"preferred": ["fullkbd"],
+		"optional": ["smcup", "kmous"]
+	}`), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Required[1]; got.Cap != "setrgbf" || !reflect.DeepEqual(got.Fallbacks, []string{"setaf"}) {
+		t.Errorf("Required[1] = %+v", got)
+	}
+	xterm, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := Check(xterm, m)
+	if !r.OK || r.Using["setrgbf"] != "setaf" || r.Using["smcup"] != "smcup" {
+		t.Errorf("xterm: %+v", r)
+	}
+	if got, want := r.String(), "ok, degraded setrgbf to setaf, unavailable fullkbd"; got != want {
+		t.Errorf("xterm: String = %q, want %q", got, want)
+	}
+	dumb, err := Load("dumb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r = Check(dumb, m)
+	if got, want := r.String(), "missing cup setrgbf, unavailable fullkbd smcup kmous"; r.OK || got != want {
+		t.Errorf("dumb: String = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,560 @@
+package terminfo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Matcher recognizes the output of a parameterized string, such as
+// caps.CursorAddress, and recovers the parameters it was evaluated with.
+// It is safe for concurrent use.
+type Matcher struct {
+	prog    *ParmProgram
+	nparams int
+}
+
+// NewMatcher compiles the parameterized string s into a Matcher.
+// An error is returned if s is malformed, as reported by ParmErr.
+func NewMatcher(s string) (*Matcher, error) {
+	prog, err := CompileParm(s)
+	if err != nil {
+		return nil, err
+	}
+	m := &Matcher{prog: prog}
+	for _, in := range prog.instrs {
+		if in.op == opParam && in.arg >= 0 && in.arg < numParams && in.arg >= m.nparams {
+			m.nparams = in.arg + 1
+		}
+	}
+	return m, nil
+}
+
+// NumParams returns the number of parameters used by the string, which is
+// the length of the parameters returned by Match.
+func (m *Matcher) NumParams() int {
+	return m.nparams
+}
+
+// maxMatchSteps bounds the instructions run by a single Match, as every
+// conditional that cannot be decided doubles the work.
+const maxMatchSteps = 1 << 14
+
+// Match reports whether b starts with an output of the string and returns
+// the parameters it was evaluated with and the length of the output.
+// The parameters are checked by evaluating the string with them, so
+// evaluating it with the returned parameters always results in b[:n]. When
+// different parameters result in the same output, such as for %/, any of
+// them may be returned. Parameters that the output does not depend on are 0.
+// Only numeric parameters are supported.
+func (m *Matcher) Match(b []byte) (params []int, n int, ok bool) {
+	return m.match(string(b))
+}
+
+// match is Match for a string.
+func (m *Matcher) match(s string) (params []int, n int, ok bool) {
+	mt := &matcher{m: m, b: s, steps: maxMatchSteps}
+	if !mt.run(0, &matchState{}) {
+		return nil, 0, false
+	}
+	return mt.params, mt.n, true
+}
+
+// Index returns the parameters and the position of the first output of the
+// string in b, or -1 if there is none.
+func (m *Matcher) Index(b []byte) (params []int, start, end int) {
+	s := string(b)
+	for i := range s {
+		if params, n, ok := m.match(s[i:]); ok {
+			return params, i, i + n
+		}
+	}
+	return nil, -1, -1
+}
+
+// symKind is the kind of a symbolic value.
+type symKind uint8
+
+const (
+	symUnknown symKind = iota // any value
+	symConst                  // n
+	symAffine                 // mul*p+n for the parameter p
+	symEqual                  // mul*p+n == c for the parameter p
+	symOr                     // p || c for the parameters p and c
+	symExpr                   // ops applied to the parameter p
+)
+
+// sym is a value on the stack while matching, which depends on at most one
+// parameter that is not yet known.
+type sym struct {
+	kind  symKind
+	n     int
+	param int
+	mul   int
+	c     int
+	ops   []exprOp
+}
+
+// exprOp is a stack operation applied to the value of a symExpr, with n as
+// the other operand.
+type exprOp struct {
+	ch   byte
+	n    int
+	left bool // n is the left operand
+}
+
+// eval returns the value of the symExpr s for the parameter value p.
+func (s sym) eval(p int) int {
+	for _, op := range s.ops {
+		if op.left {
+			p = arith(op.ch, op.n, p)
+		} else {
+			p = arith(op.ch, p, op.n)
+		}
+	}
+	return p
+}
+
+// withOp returns the symExpr of s with the operation appended, where s is a
+// symAffine or a symExpr.
+func (s sym) withOp(op exprOp) sym {
+	if s.kind == symAffine {
+		s.ops = []exprOp{{'*', s.mul, false}, {'+', s.n, false}}
+	}
+	s.kind = symExpr
+	s.ops = append(s.ops[:len(s.ops):len(s.ops)], op)
+	return s
+}
+
+// matchState is the state of one path through the program.
+type matchState struct {
+	pos    int
+	stk    []sym
+	vars   [52]sym // the static variables followed by the dynamic ones
+	bound  [numParams]bool
+	vals   [numParams]int
+	truthy [numParams]bool // the parameter is non-zero if it is not bound
+	incs   int             // number of %i seen
+}
+
+// clone returns a copy of st for exploring another path.
+func (st *matchState) clone() *matchState {
+	c := *st
+	c.stk = append([]sym(nil), st.stk...)
+	return &c
+}
+
+// resolve returns s with the bound parameters replaced by their values.
+func (st *matchState) resolve(s sym) sym {
+	if s.kind == symAffine && st.bound[s.param] {
+		return sym{kind: symConst, n: s.mul*st.vals[s.param] + s.n}
+	}
+	if s.kind == symEqual && st.bound[s.param] {
+		return constBool(s.mul*st.vals[s.param]+s.n == s.c)
+	}
+	if s.kind == symExpr && st.bound[s.param] {
+		return sym{kind: symConst, n: s.eval(st.vals[s.param])}
+	}
+	if s.kind == symOr {
+		switch {
+		case st.bound[s.param] && st.vals[s.param] != 0, st.bound[s.c] && st.vals[s.c] != 0:
+			return sym{kind: symConst, n: 1}
+		case st.bound[s.param] && st.bound[s.c]:
+			return sym{kind: symConst}
+		case st.bound[s.param]:
+			return sym{kind: symAffine, param: s.c, mul: 1}
+		case st.bound[s.c]:
+			return sym{kind: symAffine, param: s.param, mul: 1}
+		}
+	}
+	return s
+}
+
+func (st *matchState) push(s sym) {
+	st.stk = append(st.stk, s)
+}
+
+func (st *matchState) pop() sym {
+	if len(st.stk) == 0 {
+		return sym{kind: symConst}
+	}
+	s := st.stk[len(st.stk)-1]
+	st.stk = st.stk[:len(st.stk)-1]
+	return st.resolve(s)
+}
+
+// bind sets the parameter of s so that s evaluates to v, reporting whether
+// that is possible.
+func (st *matchState) bind(s sym, v int) bool {
+	if s.mul == 0 || (v-s.n)%s.mul != 0 {
+		return false
+	}
+	st.bound[s.param] = true
+	st.vals[s.param] = (v - s.n) / s.mul
+	return true
+}
+
+// maxSearch bounds the values tried for a parameter of a symExpr, which are
+// those of terminfo numbers.
+const maxSearch = 1 << 15
+
+// search binds the parameter of the symExpr s to the smallest value for
+// which s evaluates to v, reporting whether there is one.
+func (st *matchState) search(s sym, v int) bool {
+	for p := 0; p < maxSearch; p++ {
+		if s.eval(p) == v {
+			st.bound[s.param] = true
+			st.vals[s.param] = p
+			return true
+		}
+	}
+	return false
+}
+
+func constBool(b bool) sym {
+	if b {
+		return sym{kind: symConst, n: 1}
+	}
+	return sym{kind: symConst}
+}
+
+// matcher holds the input and result of a Match.
+type matcher struct {
+	m      *Matcher
+	b      string
+	steps  int
+	params []int
+	n      int
+}
+
+// run follows the program from pc, trying every undecided branch, and
+// reports whether a path matched.
+func (mt *matcher) run(pc int, st *matchState) bool {
+	instrs := mt.m.prog.instrs
+	for ; pc < len(instrs); pc++ {
+		if mt.steps--; mt.steps < 0 {
+			return false
+		}
+		in := &instrs[pc]
+		switch in.op {
+		case opText:
+			if !strings.HasPrefix(mt.b[st.pos:], in.s) {
+				return false
+			}
+			st.pos += len(in.s)
+		case opParam:
+			st.push(st.param(in.arg))
+		case opInt, opChar:
+			st.push(sym{kind: symConst, n: in.arg})
+		case opSetVar:
+			if i := varIndex(byte(in.arg)); i >= 0 {
+				st.vars[i] = st.pop()
+			} else {
+				st.pop()
+			}
+		case opGetVar:
+			if i := varIndex(byte(in.arg)); i >= 0 {
+				st.push(st.resolve(st.vars[i]))
+			} else {
+				st.push(sym{kind: symConst})
+			}
+		case opOperate:
+			if !st.operate(byte(in.arg)) {
+				return false
+			}
+		case opFormat:
+			return mt.format(pc, st, in)
+		case opIncrement:
+			st.incs++
+		case opThen:
+			return mt.then(pc, st, in.arg)
+		case opJump:
+			pc = in.arg - 1
+		}
+	}
+	return mt.check(st)
+}
+
+// check evaluates the program with the parameters found on the path and
+// reports whether it results in the matched output.
+func (mt *matcher) check(st *matchState) bool {
+	params := make([]int, mt.m.nparams)
+	p := make([]interface{}, len(params))
+	for i := range params {
+		switch {
+		case st.bound[i]:
+			params[i] = st.vals[i]
+		case st.truthy[i]:
+			params[i] = 1
+		}
+		p[i] = params[i]
+	}
+	if mt.m.prog.Eval(p...) != mt.b[:st.pos] {
+		return false
+	}
+	mt.params, mt.n = params, st.pos
+	return true
+}
+
+// param returns the value pushed by %p for the parameter i.
+func (st *matchState) param(i int) sym {
+	if i < 0 || i >= numParams {
+		return sym{kind: symConst}
+	}
+	inc := 0
+	if i < 2 {
+		inc = st.incs
+	}
+	if st.bound[i] {
+		return sym{kind: symConst, n: st.vals[i] + inc}
+	}
+	return sym{kind: symAffine, param: i, mul: 1, n: inc}
+}
+
+// varIndex returns the index of the variable ch in matchState.vars, or -1.
+func varIndex(ch byte) int {
+	switch {
+	case ch >= 'A' && ch <= 'Z':
+		return int(ch - 'A')
+	case ch >= 'a' && ch <= 'z':
+		return 26 + int(ch-'a')
+	}
+	return -1
+}
+
+// operate performs the stack operation ch on symbolic values. It reports
+// false for operations on strings, which are not supported.
+func (st *matchState) operate(ch byte) bool {
+	switch ch {
+	case 'l':
+		return false
+	case '!', '~':
+		a := st.pop()
+		switch {
+		case a.kind == symConst && ch == '!':
+			st.push(constBool(a.n == 0))
+		case a.kind == symConst:
+			st.push(sym{kind: symConst, n: ^a.n})
+		case (a.kind == symAffine || a.kind == symExpr) && ch == '~':
+			st.push(a.withOp(exprOp{ch: '^', n: -1}))
+		default:
+			st.push(sym{})
+		}
+		return true
+	}
+	b, a := st.pop(), st.pop()
+	switch {
+	case a.kind == symConst && b.kind == symConst:
+		st.push(sym{kind: symConst, n: arith(ch, a.n, b.n)})
+	case a.kind == symAffine && b.kind == symConst && ch == '+':
+		a.n += b.n
+		st.push(a)
+	case a.kind == symAffine && b.kind == symConst && ch == '-':
+		a.n -= b.n
+		st.push(a)
+	case a.kind == symAffine && b.kind == symConst && ch == '*':
+		a.mul, a.n = a.mul*b.n, a.n*b.n
+		st.push(a)
+	case a.kind == symAffine && b.kind == symConst && ch == '=':
+		a.kind, a.c = symEqual, b.n
+		st.push(a)
+	case a.kind == symConst && b.kind == symAffine && ch == '+':
+		b.n += a.n
+		st.push(b)
+	case a.kind == symConst && b.kind == symAffine && ch == '-':
+		b.mul, b.n = -b.mul, a.n-b.n
+		st.push(b)
+	case a.kind == symConst && b.kind == symAffine && ch == '*':
+		b.mul, b.n = b.mul*a.n, b.n*a.n
+		st.push(b)
+	case a.kind == symConst && b.kind == symAffine && ch == '=':
+		b.kind, b.c = symEqual, a.n
+		st.push(b)
+	case (ch == '|' || ch == 'O') && isParam(a) && isParam(b):
+		st.push(sym{kind: symOr, param: a.param, c: b.param})
+	case (a.kind == symAffine || a.kind == symExpr) && b.kind == symConst:
+		st.push(a.withOp(exprOp{ch: ch, n: b.n}))
+	case a.kind == symConst && (b.kind == symAffine || b.kind == symExpr):
+		st.push(b.withOp(exprOp{ch: ch, n: a.n, left: true}))
+	default:
+		st.push(sym{})
+	}
+	return true
+}
+
+// isParam reports whether s is the value of a parameter that is not known.
+func isParam(s sym) bool {
+	return s.kind == symAffine && s.mul == 1 && s.n == 0
+}
+
+// arith performs the binary stack operation ch on numbers, as Parm does.
+func arith(ch byte, a, b int) int {
+	switch ch {
+	case '+':
+		return a + b
+	case '-':
+		return a - b
+	case '*':
+		return a * b
+	case '/':
+		if b != 0 {
+			return a / b
+		}
+	case 'm':
+		if b != 0 {
+			return a % b
+		}
+	case '&':
+		return a & b
+	case '|':
+		return a | b
+	case '^':
+		return a ^ b
+	case '=':
+		return constBool(a == b).n
+	case '>':
+		return constBool(a > b).n
+	case '<':
+		return constBool(a < b).n
+	case 'A':
+		return constBool(a != 0 && b != 0).n
+	case 'O':
+		return constBool(a != 0 || b != 0).n
+	}
+	return 0
+}
+
+// then follows both branches of the conditional at pc unless the condition
+// is known, binding the parameter it depends on where possible.
+func (mt *matcher) then(pc int, st *matchState, elsePC int) bool {
+	cond := st.pop()
+	switch cond.kind {
+	case symConst:
+		if cond.n != 0 {
+			return mt.run(pc+1, st)
+		}
+		return mt.run(elsePC, st)
+	case symAffine:
+		t := st.clone()
+		t.truthy[cond.param] = cond.mul+cond.n != 0
+		if mt.run(pc+1, t) {
+			return true
+		}
+		if st.bind(cond, 0) {
+			return mt.run(elsePC, st)
+		}
+		return false
+	case symEqual:
+		if t := st.clone(); t.bind(cond, cond.c) && mt.run(pc+1, t) {
+			return true
+		}
+		return mt.run(elsePC, st)
+	case symOr:
+		t := st.clone()
+		t.truthy[cond.param] = true
+		if mt.run(pc+1, t) {
+			return true
+		}
+		st.bound[cond.param], st.bound[cond.c] = true, true
+		st.vals[cond.param], st.vals[cond.c] = 0, 0
+		return mt.run(elsePC, st)
+	}
+	return mt.run(pc+1, st.clone()) || mt.run(elsePC, st)
+}
+
+// format matches the output of the conversion in at pc, trying every
+// number the input could hold.
+func (mt *matcher) format(pc int, st *matchState, in *instruction) bool {
+	v := st.pop()
+	rest := mt.b[st.pos:]
+	verb := byte(in.arg)
+	if verb == 's' {
+		return false
+	}
+	if verb == 'c' {
+		if rest == "" {
+			return false
+		}
+		if v.kind == symConst {
+			// Compare as written by %c, which turns 0 into \200.
+			if c := byte(v.n); rest[0] != c && !(c == 0 && rest[0] == 0200) {
+				return false
+			}
+			v = sym{}
+		}
+		return mt.bindFormat(pc, st, v, int(rest[0]), 1)
+	}
+	base := 10
+	switch verb {
+	case 'o':
+		base = 8
+	case 'x', 'X':
+		base = 16
+	}
+	i := 0
+	for i < len(rest) && rest[i] == ' ' {
+		i++
+	}
+	neg := false
+	if i < len(rest) && (rest[i] == '-' || rest[i] == '+') {
+		neg = rest[i] == '-'
+		i++
+	}
+	if strings.Contains(in.s, "#") && base == 16 && len(rest) > i+1 && rest[i] == '0' && (rest[i+1] == 'x' || rest[i+1] == 'X') {
+		i += 2
+	}
+	start := i
+	for i < len(rest) && digitValue(rest[i]) < base {
+		i++
+	}
+	// Try the longest number first.
+	for end := i; end > start; end-- {
+		n, err := strconv.ParseInt(rest[start:end], base, 64)
+		if err != nil {
+			continue
+		}
+		if neg {
+			n = -n
+		}
+		if base != 10 {
+			n = int64(int32(uint32(n)))
+		}
+		if mt.bindFormat(pc, st.clone(), v, int(n), end) {
+			return true
+		}
+	}
+	return false
+}
+
+// bindFormat continues after a conversion of v that wrote the number n in
+// length bytes.
+func (mt *matcher) bindFormat(pc int, st *matchState, v sym, n, length int) bool {
+	switch v.kind {
+	case symConst:
+		if v.n != n {
+			return false
+		}
+	case symAffine:
+		if !st.bind(v, n) {
+			return false
+		}
+	case symExpr:
+		if !st.search(v, n) {
+			return false
+		}
+	}
+	st.pos += length
+	return mt.run(pc+1, st)
+}
+
+// digitValue returns the value of the digit ch, or 16 if it is not one.
+func digitValue(ch byte) int {
+	switch {
+	case ch >= '0' && ch <= '9':
+		return int(ch - '0')
+	case ch >= 'a' && ch <= 'f':
+		return int(ch-'a') + 10
+	case ch >= 'A' && ch <= 'F':
+		return int(ch-'A') + 10
+	}
+	return 16
+}
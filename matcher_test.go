@@ -0,0 +1,58 @@
+package terminfo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatcher(t *testing.T) {
+	const (
+		cup   = "\x1b[%i%p1%d;%p2%dH"
+		setaf = "\x1b[%?%p1%{8}%<%t3%p1%d%e%p1%{16}%<%t9%p1%{8}%-%d%e38;5;%p1%d%;m"
+		sgr   = "%?%p9%t\x1b(0%e\x1b(B%;\x1b[0%?%p6%t;1%;%?%p5%t;2%;%?%p2%t;4%;%?%p1%p3%|%t;7%;%?%p4%t;5%;%?%p7%t;8%;m"
+		initc = "\x1b]4;%p1%d;rgb:%p2%{255}%*%{1000}%/%2.2X/%p3%{255}%*%{1000}%/%2.2X/%p4%{255}%*%{1000}%/%2.2X\x1b\\"
+		vt52  = "\x1bY%p1%' '%+%c%p2%' '%+%c"
+	)
+	tests := []struct {
+		s    string
+		p    []int
+		want []int // if different from p
+	}{
+		{cup, []int{4, 10}, nil},
+		{setaf, []int{1}, nil},
+		{setaf, []int{12}, nil},
+		{setaf, []int{200}, nil},
+		{sgr, []int{0, 1, 0, 0, 0, 1, 0, 0, 1}, nil},
+		{sgr, []int{0, 0, 1, 0, 0, 0, 0, 0, 0}, []int{1, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{initc, []int{3, 1000, 0, 1000}, nil},
+		{vt52, []int{0, 79}, nil},
+		{"\x1b[%p1%03dX", []int{7}, nil},
+		{"\x1b[%p1%xX", []int{255}, nil},
+	}
+	for _, tt := range tests {
+		p := make([]interface{}, len(tt.p))
+		for i := range tt.p {
+			p[i] = tt.p[i]
+		}
+		out := Parm(tt.s, p...)
+		m, err := NewMatcher(tt.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := tt.want
+		if want == nil {
+			want = tt.p
+		}
+		got, n, ok := m.Match([]byte(out + "\x1b[m"))
+		if !ok || n != len(out) || !reflect.DeepEqual(got, want) {
+			t.Errorf("%q: Match(%q) = %v, %d, %v; want %v, %d", tt.s, out, got, n, ok, want, len(out))
+		}
+	}
+	m, _ := NewMatcher(cup)
+	if _, _, ok := m.Match([]byte("\x1b[12;H")); ok {
+		t.Error("matched cup without a column")
+	}
+	if p, start, end := m.Index([]byte("ab\x1b[3;4Hc")); start != 2 || end != 8 || !reflect.DeepEqual(p, []int{2, 3}) {
+		t.Errorf("Index = %v, %d, %d", p, start, end)
+	}
+}
@@ -0,0 +1,45 @@
+//go:build ignore
+// +build ignore
+
+// mkaccessors generates accessors.go, which holds a method of Terminfo
+// for every standard string capability.
+//
+// Usage:
+//
+//	go run mkaccessors.go [-o accessors.go]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func main() {
+	out := flag.String("o", "accessors.go", "output file")
+	flag.Parse()
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by mkaccessors.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package terminfo")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, `import "github.com/nhooyr/terminfo/caps"`)
+	for _, c := range caps.StringCaps {
+		fmt.Fprintf(buf, "\n// %s returns the %s (%s) capability. ok is false if the entry lacks it.\n", c.GoName, c.LongName, c.Name)
+		fmt.Fprintf(buf, "func (ti *Terminfo) %s() (s string, ok bool) {\n", c.GoName)
+		fmt.Fprintf(buf, "return ti.stringCap(caps.%s)\n}\n", c.GoName)
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(*out, b, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,202 @@
+package terminfo
+
+import (
+	"io"
+	"sync"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// EnterKeypad returns the string making the keypad send the sequences of the
+// key capabilities (smkx), which KeyDecoder expects.
+func (ti *Terminfo) EnterKeypad() string {
+	return ti.Strings[caps.KeypadXmit]
+}
+
+// ExitKeypad returns the string restoring the keypad (rmkx).
+func (ti *Terminfo) ExitKeypad() string {
+	return ti.Strings[caps.KeypadLocal]
+}
+
+// EnableBracketedPaste returns the string making the terminal surround
+// pasted text with the markers returned by PasteMarkers. It is the extended
+// capability BE, or xterm's mode 2004 for terminals known to support it as
+// for SetTitle. The result is empty for other terminals.
+func (ti *Terminfo) EnableBracketedPaste() string {
+	if be, ok := ti.ExtString("BE"); ok {
+		return be
+	}
+	if !ti.xtermLike() {
+		return ""
+	}
+	return PrivateCSI('?', []int{2004}, "h")
+}
+
+// DisableBracketedPaste returns the string turning off bracketed paste,
+// from the extended capability BD or xterm's mode 2004.
+func (ti *Terminfo) DisableBracketedPaste() string {
+	if bd, ok := ti.ExtString("BD"); ok {
+		return bd
+	}
+	if !ti.xtermLike() {
+		return ""
+	}
+	return PrivateCSI('?', []int{2004}, "l")
+}
+
+// PasteMarkers returns the sequences the terminal sends before and after
+// pasted text in bracketed paste mode, from the extended capabilities PS
+// and PE or xterm's defaults.
+func (ti *Terminfo) PasteMarkers() (start, end string) {
+	start, end = "\x1b[200~", "\x1b[201~"
+	if ps, ok := ti.ExtString("PS"); ok {
+		start = ps
+	}
+	if pe, ok := ti.ExtString("PE"); ok {
+		end = pe
+	}
+	return start, end
+}
+
+// Mode is a set of terminal modes tracked by Modes.
+type Mode uint
+
+// These are the modes turned on by Term.
+const (
+	// ModeCA is the alternate screen of EnterCA.
+	ModeCA Mode = 1 << iota
+	// ModeKeypad is the keypad transmit mode of EnterKeypad.
+	ModeKeypad
+	// ModeCursorHidden is the invisible cursor of HideCursor.
+	ModeCursorHidden
+	// ModeBracketedPaste is the mode of EnableBracketedPaste.
+	ModeBracketedPaste
+	// ModeMouse is mouse reporting, see EnableMouse.
+	ModeMouse
+	// ModeXon is xon/xoff handshaking of EnterXon.
+	ModeXon
+	// ModeAttributes is any attribute turned on by SetStyle or SetAttrs.
+	ModeAttributes
+)
+
+// restoreOrder is the order in which Restore turns off the modes, the
+// reverse of the order in which programs usually turn them on.
+var restoreOrder = []Mode{
+	ModeAttributes, ModeMouse, ModeBracketedPaste, ModeKeypad,
+	ModeCursorHidden, ModeXon, ModeCA,
+}
+
+// Modes records the modes that were turned on. It is safe for concurrent
+// use, so that a terminal can be restored from a signal handler.
+type Modes struct {
+	mu sync.Mutex
+	on Mode
+}
+
+// Set records that the modes were turned on.
+func (m *Modes) Set(mode Mode) {
+	m.mu.Lock()
+	m.on |= mode
+	m.mu.Unlock()
+}
+
+// Clear records that the modes were turned off.
+func (m *Modes) Clear(mode Mode) {
+	m.mu.Lock()
+	m.on &^= mode
+	m.mu.Unlock()
+}
+
+// Has reports whether all of the modes are on.
+func (m *Modes) Has(mode Mode) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.on&mode == mode
+}
+
+// On returns the modes that are on.
+func (m *Modes) On() Mode {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.on
+}
+
+// modeStrings returns the strings turning the single mode on and off.
+// Mouse reporting is turned on for clicks.
+func (ti *Terminfo) modeStrings(mode Mode) (on, off string) {
+	switch mode {
+	case ModeCA:
+		return ti.Strings[caps.EnterCaMode], ti.Strings[caps.ExitCaMode]
+	case ModeKeypad:
+		return ti.EnterKeypad(), ti.ExitKeypad()
+	case ModeCursorHidden:
+		return ti.Strings[caps.CursorInvisible], ti.Strings[caps.CursorNormal]
+	case ModeBracketedPaste:
+		return ti.EnableBracketedPaste(), ti.DisableBracketedPaste()
+	case ModeMouse:
+		return ti.EnableMouse(MouseClicks), ti.DisableMouse()
+	case ModeXon:
+		return ti.Strings[caps.EnterXonMode], ti.Strings[caps.ExitXonMode]
+	case ModeAttributes:
+		return "", ti.StyleReset()
+	}
+	return "", ""
+}
+
+// SetMode turns the single mode on or off and records it in t.Modes.
+// Nothing is written if the terminal lacks the string for it, and the mode
+// is then not recorded as on. ModeAttributes can only be turned off.
+func (t *Term) SetMode(mode Mode, on bool) error {
+	enter, exit := t.modeStrings(mode)
+	if on {
+		return t.enter(mode, enter)
+	}
+	return t.exit(t.w, mode, exit)
+}
+
+// SetMouse turns on mouse reporting in the mode and records ModeMouse.
+func (t *Term) SetMouse(mode MouseMode) error {
+	return t.enter(ModeMouse, t.EnableMouse(mode))
+}
+
+// enter writes s turning on the mode and records it.
+func (t *Term) enter(mode Mode, s string) error {
+	if s == "" {
+		return nil
+	}
+	if err := t.putTo(t.w, s, false); err != nil {
+		return err
+	}
+	t.Modes.Set(mode)
+	return nil
+}
+
+// exit writes s turning off the mode to w and records it.
+func (t *Term) exit(w io.Writer, mode Mode, s string) error {
+	if s != "" {
+		if err := t.putTo(w, s, false); err != nil {
+			return err
+		}
+	}
+	t.Modes.Clear(mode)
+	return nil
+}
+
+// Restore writes to w the strings turning off the modes recorded in t.Modes,
+// leaving the terminal as it was before the program changed it. It is meant
+// to be deferred, or called on exit or panic, and writes nothing if no mode
+// is on. w is usually the output of t, but may be another descriptor of the
+// terminal.
+func (t *Term) Restore(w io.Writer) error {
+	on := t.Modes.On()
+	for _, mode := range restoreOrder {
+		if on&mode == 0 {
+			continue
+		}
+		_, off := t.modeStrings(mode)
+		if err := t.exit(w, mode, off); err != nil {
+			return err
+		}
+	}
+	return nil
+}
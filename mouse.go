@@ -0,0 +1,174 @@
+package terminfo
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// MouseMode is which mouse events a terminal reports.
+type MouseMode int
+
+// These are the mouse modes of xterm.
+const (
+	// MouseClicks reports button presses and releases and wheel motion.
+	MouseClicks MouseMode = iota
+	// MouseDrag also reports motion while a button is held.
+	MouseDrag
+	// MouseMotion reports all motion.
+	MouseMotion
+)
+
+// mouseModes are the private modes of xterm enabling the mouse modes.
+var mouseModes = [...]string{"1000", "1002", "1003"}
+
+// sgrMouse is the prefix of mouse reports in the SGR format, mode 1006.
+const sgrMouse = "\x1b[<"
+
+// x10Mouse is the prefix of mouse reports in the original X10 format.
+const x10Mouse = "\x1b[M"
+
+// EnableMouse returns the string making the terminal report mouse events of
+// the mode. It is built from the extended capability XM, as defined by
+// ncurses, if the entry has it. Otherwise xterm's modes are used with SGR
+// reports (mode 1006), provided the entry has kmous. The result is empty
+// for terminals without mouse support.
+func (ti *Terminfo) EnableMouse(mode MouseMode) string {
+	if mode < MouseClicks || mode > MouseMotion {
+		return ""
+	}
+	if xm, _ := ti.ExtString("XM"); xm != "" {
+		s := Parm(xm, 1)
+		if mode != MouseClicks {
+			s += "\x1b[?" + mouseModes[mode] + "h"
+		}
+		return s
+	}
+	if ti.Strings[caps.KeyMouse] == "" {
+		return ""
+	}
+	return "\x1b[?" + mouseModes[mode] + ";1006h"
+}
+
+// DisableMouse returns the string turning off the mouse reports enabled by
+// EnableMouse in any mode.
+func (ti *Terminfo) DisableMouse() string {
+	if xm, _ := ti.ExtString("XM"); xm != "" {
+		return Parm(xm, 0) + "\x1b[?1002;1003l"
+	}
+	if ti.Strings[caps.KeyMouse] == "" {
+		return ""
+	}
+	return "\x1b[?1000;1002;1003;1006l"
+}
+
+// MouseButton is the button of a MouseEvent.
+type MouseButton int
+
+// These are the mouse buttons. Wheel motion is reported as presses of the
+// wheel buttons.
+const (
+	MouseNoButton MouseButton = iota
+	MouseLeft
+	MouseMiddle
+	MouseRight
+	MouseWheelUp
+	MouseWheelDown
+	MouseWheelLeft
+	MouseWheelRight
+)
+
+// MouseEvent is a mouse report decoded by a KeyDecoder, in the Mouse field
+// of a KeyEvent for the kmous capability. The modifiers are in the Mod
+// field of the KeyEvent.
+type MouseEvent struct {
+	Button MouseButton
+	// Release is whether the button was released rather than pressed.
+	// The X10 format does not tell which button was released.
+	Release bool
+	// Motion is whether the mouse moved, with Button held if it is set.
+	Motion bool
+	// X and Y are the column and line of the mouse, starting at 0.
+	X, Y int
+}
+
+// maxSGRMouse bounds the length of a mouse report in the SGR format after
+// its prefix, so that garbage is not buffered indefinitely.
+const maxSGRMouse = 32
+
+// mouse decodes the mouse report at the start of b, the sequence of the
+// kmous event ev having matched. Reports that cannot be decoded are returned
+// as the bare kmous event.
+func (kd *KeyDecoder) mouse(b []byte, ev KeyEvent, flush bool) (KeyEvent, int, bool) {
+	bare := len(ev.Seq)
+	switch {
+	case strings.HasPrefix(string(b), sgrMouse):
+		rest := b[len(sgrMouse):]
+		i := 0
+		for i < len(rest) && i < maxSGRMouse && (rest[i] == ';' || rest[i] >= '0' && rest[i] <= '9') {
+			i++
+		}
+		if i == len(rest) && i < maxSGRMouse && !flush {
+			return ev, 0, false
+		}
+		if i == len(rest) || rest[i] != 'M' && rest[i] != 'm' {
+			break
+		}
+		end := len(sgrMouse) + i
+		f := strings.Split(string(b[len(sgrMouse):end]), ";")
+		if len(f) != 3 {
+			break
+		}
+		var v [3]int
+		for i := range f {
+			n, err := strconv.Atoi(f[i])
+			if err != nil {
+				return ev, bare, true
+			}
+			v[i] = n
+		}
+		ev.Mouse, ev.Mod = mouseButton(v[0], b[end] == 'm')
+		ev.Mouse.X, ev.Mouse.Y = v[1]-1, v[2]-1
+		ev.Seq = string(b[:end+1])
+		return ev, end + 1, true
+	case strings.HasPrefix(string(b), x10Mouse):
+		n := len(x10Mouse) + 3
+		if len(b) < n {
+			if !flush {
+				return ev, 0, false
+			}
+			break
+		}
+		cb := int(b[n-3]) - 32
+		ev.Mouse, ev.Mod = mouseButton(cb, cb&3 == 3 && cb&(32|64) == 0)
+		ev.Mouse.X, ev.Mouse.Y = int(b[n-2])-33, int(b[n-1])-33
+		ev.Seq = string(b[:n])
+		return ev, n, true
+	}
+	return ev, bare, true
+}
+
+// mouseButton decodes the button code of a mouse report.
+func mouseButton(cb int, release bool) (MouseEvent, Modifier) {
+	var mod Modifier
+	if cb&4 != 0 {
+		mod |= ModShift
+	}
+	if cb&8 != 0 {
+		mod |= ModAlt
+	}
+	if cb&16 != 0 {
+		mod |= ModCtrl
+	}
+	me := MouseEvent{Release: release, Motion: cb&32 != 0}
+	switch b := cb & 3; {
+	case cb&128 != 0:
+		// Buttons 8 to 11 have no name.
+	case cb&64 != 0:
+		me.Button = MouseWheelUp + MouseButton(b)
+	case b != 3:
+		me.Button = MouseLeft + MouseButton(b)
+	}
+	return me, mod
+}
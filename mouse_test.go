@@ -0,0 +1,70 @@
+package terminfo
+
+import (
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestMouseModes(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti = ti.Clone()
+	ti.ExtStrings["XM"] = "\x1b[?1006;1000%?%p1%{1}%=%th%el%;"
+	if got, want := ti.EnableMouse(MouseDrag), "\x1b[?1006;1000h\x1b[?1002h"; got != want {
+		t.Errorf("EnableMouse = %q, want %q", got, want)
+	}
+	if got, want := ti.DisableMouse(), "\x1b[?1006;1000l\x1b[?1002;1003l"; got != want {
+		t.Errorf("DisableMouse = %q, want %q", got, want)
+	}
+	delete(ti.ExtStrings, "XM")
+	if got, want := ti.EnableMouse(MouseClicks), "\x1b[?1000;1006h"; got != want {
+		t.Errorf("EnableMouse without XM = %q, want %q", got, want)
+	}
+	ti.Strings[caps.KeyMouse] = ""
+	if s := ti.EnableMouse(MouseClicks) + ti.DisableMouse(); s != "" {
+		t.Errorf("got %q for a terminal without a mouse", s)
+	}
+}
+
+func TestMouseDecode(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kd := ti.NewKeyDecoder()
+	in := "\x1b[<0;10;5M" + "\x1b[<2;10;5m" + "\x1b[<20;1;1M" + "\x1b[<65;3;4M" + "\x1b[M @!" + "\x1b[M#\"#" + "a"
+	var evs []KeyEvent
+	// Feed the input a byte at a time to exercise partial reports.
+	for i := range in {
+		e, err := kd.Feed([]byte{in[i]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		evs = append(evs, e...)
+	}
+	want := []MouseEvent{
+		{Button: MouseLeft, X: 9, Y: 4},
+		{Button: MouseRight, Release: true, X: 9, Y: 4},
+		{Button: MouseLeft, X: 0, Y: 0},
+		{Button: MouseWheelDown, X: 2, Y: 3},
+		{Button: MouseLeft, X: 31, Y: 0},
+		{Release: true, X: 1, Y: 2},
+	}
+	if len(evs) != len(want)+1 {
+		t.Fatalf("got %d events %+v, want %d", len(evs), evs, len(want)+1)
+	}
+	for i, w := range want {
+		if evs[i].Key != caps.KeyMouse || evs[i].Mouse != w {
+			t.Errorf("event %d = %+v, want %+v", i, evs[i], w)
+		}
+	}
+	if evs[2].Mod != ModCtrl|ModShift {
+		t.Errorf("got modifiers %v, want Ctrl+Shift", evs[2].Mod)
+	}
+	if evs[6].Rune != 'a' {
+		t.Errorf("input after the reports = %+v", evs[6])
+	}
+}
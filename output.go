@@ -0,0 +1,92 @@
+package terminfo
+
+import (
+	"os"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Dumb describes a terminal without any capabilities. It is used for outputs
+// that are not terminals, such as pipes and files.
+var Dumb = &Terminfo{
+	Names: []string{"dumb", "80-column dumb tty"},
+	Bools: [caps.BoolCount]bool{
+		caps.AutoRightMargin: true,
+	},
+	Numbers: [caps.NumberCount]int16{
+		caps.Columns: 80,
+	},
+	Strings: [caps.StringCount]string{
+		caps.Bell:           "\a",
+		caps.CarriageReturn: "\r",
+		caps.CursorDown:     "\n",
+		caps.ScrollForward:  "\n",
+	},
+}
+
+// OutputOptions controls how LoadOutput selects the entry for an output.
+type OutputOptions struct {
+	// RenderAs names an entry, such as "xterm-256color", that is used
+	// regardless of whether the output is a terminal and of $NO_COLOR.
+	// This is useful for generating ANSI art or files meant to be
+	// displayed later with cat.
+	RenderAs string
+}
+
+// LoadOutput returns the entry that should be used to render output to f.
+//
+// If opts.RenderAs is set, that entry is loaded. Otherwise, a copy of Dumb is
+// returned if f is not a terminal and the entry for $TERM is returned if it
+// is.
+// When $NO_COLOR is set to a non-empty value, as described at
+// https://no-color.org, the colors of the $TERM entry are removed.
+func LoadOutput(f *os.File, opts OutputOptions) (*Terminfo, error) {
+	if opts.RenderAs != "" {
+		return Load(opts.RenderAs)
+	}
+	if !IsTerminal(f) {
+		return Dumb.Clone(), nil
+	}
+	ti, err := LoadEnv()
+	if err != nil {
+		return nil, err
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		ti = withoutColors(ti)
+	}
+	return ti, nil
+}
+
+// IsTerminal reports whether f is a terminal (a character device).
+func IsTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+// withoutColors returns a copy of ti that does not support colors.
+func withoutColors(ti *Terminfo) *Terminfo {
+	nti := ti.Clone()
+	nti.Numbers[caps.MaxColors] = 0
+	nti.Numbers[caps.MaxPairs] = 0
+	for _, i := range []int{
+		caps.SetAForeground,
+		caps.SetABackground,
+		caps.SetForeground,
+		caps.SetBackground,
+		caps.SetColorPair,
+		caps.InitializeColor,
+		caps.InitializePair,
+		caps.OrigPair,
+		caps.OrigColors,
+	} {
+		nti.Strings[i] = ""
+	}
+	// Direct colors.
+	for _, name := range []string{"RGB", "Tc", "setrgbf", "setrgbb"} {
+		nti.Set(name, Capability{Kind: CapBool})
+	}
+	return nti
+}
@@ -0,0 +1,63 @@
+package terminfo
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestLoadOutput(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	ti, err := LoadOutput(w, OutputOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti == Dumb || !reflect.DeepEqual(ti.Names, Dumb.Names) {
+		t.Errorf("got %v for a pipe, want a copy of dumb", ti.Names)
+	}
+	os.Setenv("NO_COLOR", "1")
+	defer os.Unsetenv("NO_COLOR")
+	ti, err = LoadOutput(w, OutputOptions{RenderAs: "xterm"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Strings[caps.SetAForeground] == "" {
+		t.Error("RenderAs did not override NO_COLOR")
+	}
+}
+
+func TestWithoutColors(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nti := withoutColors(ti)
+	if nti.Color(1, 2) != "" || nti.Numbers[caps.MaxColors] != 0 {
+		t.Error("colors were not removed")
+	}
+	if ti.Strings[caps.SetAForeground] == "" {
+		t.Error("the original entry was modified")
+	}
+}
+
+func TestWithoutDirectColors(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti.Set("Tc", Capability{Kind: CapBool, Present: true, Bool: true})
+	nti := withoutColors(ti)
+	if nti.DirectColor() || nti.ColorRGB(255, 0, 0, -1, -1, -1) != "" || nti.Color(196, -1) != "" {
+		t.Error("direct colors were not removed")
+	}
+	if !ti.ExtBool("Tc") {
+		t.Error("the original entry was modified")
+	}
+}
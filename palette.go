@@ -0,0 +1,211 @@
+package terminfo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// ColorKind is the kind of a Color.
+type ColorKind int
+
+// These are the kinds of colors.
+const (
+	ColorDefault ColorKind = iota // the terminal's default color
+	ColorIndex                    // a color of the palette
+	ColorRGB                      // a 24-bit color
+)
+
+// Color is a color used by an application, independent of the terminal.
+// The zero value is the terminal's default color.
+type Color struct {
+	Kind ColorKind
+	// Index is the palette color of ColorIndex colors. The first 16 are the
+	// ANSI colors and the rest follow xterm's 256 color palette.
+	Index int
+	// R, G and B are the components of ColorRGB colors, from 0 to 255.
+	R, G, B int
+}
+
+// PaletteColor returns the color c of the palette.
+func PaletteColor(c int) Color {
+	return Color{Kind: ColorIndex, Index: c}
+}
+
+// RGBColor returns the 24-bit color r, g, b.
+func RGBColor(r, g, b int) Color {
+	return Color{Kind: ColorRGB, R: r, G: g, B: b}
+}
+
+// colorNames are the names of the ANSI colors. The bright versions are
+// prefixed with "bright".
+var colorNames = [8]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// ErrBadColor is returned by ParseColor for a string that is not a color.
+var ErrBadColor = errors.New("terminfo: bad color")
+
+// ParseColor parses a color written as "default", the name of an ANSI color
+// such as "red" or "brightred", a palette index such as "196" or a 24-bit
+// color such as "#ff0000".
+func ParseColor(s string) (Color, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "default" || s == "" {
+		return Color{}, nil
+	}
+	if strings.HasPrefix(s, "#") {
+		if len(s) != 7 {
+			return Color{}, ErrBadColor
+		}
+		v, err := strconv.ParseUint(s[1:], 16, 32)
+		if err != nil {
+			return Color{}, ErrBadColor
+		}
+		return RGBColor(int(v>>16), int(v>>8&0xff), int(v&0xff)), nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 0 || n > 255 {
+			return Color{}, ErrBadColor
+		}
+		return PaletteColor(n), nil
+	}
+	base := 0
+	if strings.HasPrefix(s, "bright") {
+		s = strings.TrimLeft(s[len("bright"):], " -_")
+		base = 8
+	}
+	for i, n := range colorNames {
+		if s == n {
+			return PaletteColor(base + i), nil
+		}
+	}
+	return Color{}, ErrBadColor
+}
+
+// String returns the color in the format read by ParseColor.
+func (c Color) String() string {
+	switch c.Kind {
+	case ColorIndex:
+		if c.Index >= 8 && c.Index < 16 {
+			return "bright" + colorNames[c.Index-8]
+		}
+		if c.Index >= 0 && c.Index < 8 {
+			return colorNames[c.Index]
+		}
+		return strconv.Itoa(c.Index)
+	case ColorRGB:
+		return fmt.Sprintf("#%02x%02x%02x", clampByte(c.R), clampByte(c.G), clampByte(c.B))
+	}
+	return "default"
+}
+
+// Palette describes the colors a terminal can display.
+type Palette struct {
+	// Colors is the number of colors of the palette, max_colors.
+	Colors int
+	// Direct is whether 24-bit colors are supported, see DirectColor.
+	Direct bool
+}
+
+// Palette returns the color model of the terminal.
+func (ti *Terminfo) Palette() Palette {
+	return Palette{Colors: int(ti.Numbers[caps.MaxColors]), Direct: ti.DirectColor()}
+}
+
+// Map returns the color the terminal displays for c. Palette colors the
+// palette does not hold are sent as 24-bit colors if the terminal supports
+// them and approximated by the nearest palette color otherwise, like 24-bit
+// colors on terminals without Direct. Colors that cannot be displayed at
+// all are mapped to the default color.
+func (p Palette) Map(c Color) Color {
+	switch c.Kind {
+	case ColorIndex:
+		i := c.Index
+		if i < 0 || i >= 256 {
+			return Color{}
+		}
+		// Map bright colors to lower versions if the palette only holds 8.
+		if p.Colors == 8 && i > 7 && i < 16 {
+			i -= 8
+		}
+		if i < p.Colors {
+			return PaletteColor(i)
+		}
+		r, g, b := xtermColor(i)
+		return p.Map(RGBColor(r, g, b))
+	case ColorRGB:
+		r, g, b := clampByte(c.R), clampByte(c.G), clampByte(c.B)
+		if r < 0 || g < 0 || b < 0 {
+			return Color{}
+		}
+		if p.Direct {
+			return RGBColor(r, g, b)
+		}
+		if p.Colors < 8 {
+			return Color{}
+		}
+		return PaletteColor(nearestColor(p.Colors, r, g, b))
+	}
+	return Color{}
+}
+
+// RGB returns the components of c as displayed by a terminal using xterm's
+// default palette of the size of p. ok is false for the default color.
+func (p Palette) RGB(c Color) (r, g, b int, ok bool) {
+	c = p.Map(c)
+	switch c.Kind {
+	case ColorIndex:
+		r, g, b = paletteColor(paletteSize(p.Colors), c.Index)
+		return r, g, b, true
+	case ColorRGB:
+		return c.R, c.G, c.B, true
+	}
+	return 0, 0, 0, false
+}
+
+// Theme maps the names of an application's colors, such as "error" or
+// "selection", to colors.
+type Theme map[string]Color
+
+// ParseTheme parses a theme of name and color pairs, such as
+// map[string]string{"error": "brightred", "accent": "#5f87ff"}.
+func ParseTheme(m map[string]string) (Theme, error) {
+	t := make(Theme, len(m))
+	for name, s := range m {
+		c, err := ParseColor(s)
+		if err != nil {
+			return nil, err
+		}
+		t[name] = c
+	}
+	return t, nil
+}
+
+// MapTheme returns the theme with each color mapped to what the palette displays.
+func (p Palette) MapTheme(t Theme) Theme {
+	mt := make(Theme, len(t))
+	for name, c := range t {
+		mt[name] = p.Map(c)
+	}
+	return mt
+}
+
+// SetColors returns the string setting the foreground and background colors
+// fg and bg. Default colors are left unchanged; use op to restore them.
+func (ti *Terminfo) SetColors(fg, bg Color) string {
+	p := ti.Palette()
+	var b strings.Builder
+	set := func(c Color, i int, rgbName string, sgr int) {
+		switch c = p.Map(c); c.Kind {
+		case ColorIndex:
+			ti.colorTo(&b, c.Index, i, rgbName, sgr)
+		case ColorRGB:
+			b.WriteString(ti.rgb(c.R, c.G, c.B, i, rgbName, sgr))
+		}
+	}
+	set(fg, caps.SetAForeground, "setrgbf", 38)
+	set(bg, caps.SetABackground, "setrgbb", 48)
+	return b.String()
+}
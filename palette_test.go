@@ -0,0 +1,107 @@
+package terminfo
+
+import (
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestParseColor(t *testing.T) {
+	for s, want := range map[string]Color{
+		"":            {},
+		"Default":     {},
+		"red":         PaletteColor(1),
+		"bright-blue": PaletteColor(12),
+		"brightwhite": PaletteColor(15),
+		"196":         PaletteColor(196),
+		"#5f87ff":     RGBColor(0x5f, 0x87, 0xff),
+	} {
+		c, err := ParseColor(s)
+		if err != nil {
+			t.Errorf("ParseColor(%q): %v", s, err)
+			continue
+		}
+		if c != want {
+			t.Errorf("ParseColor(%q) = %v, want %v", s, c, want)
+		}
+		if c2, _ := ParseColor(c.String()); c2 != c {
+			t.Errorf("ParseColor(%q) does not round trip: %v", c.String(), c2)
+		}
+	}
+	for _, s := range []string{"256", "#12345", "#gggggg", "purple"} {
+		if _, err := ParseColor(s); err != ErrBadColor {
+			t.Errorf("ParseColor(%q) = %v, want ErrBadColor", s, err)
+		}
+	}
+}
+
+func TestPaletteMap(t *testing.T) {
+	theme, err := ParseTheme(map[string]string{
+		"error":  "brightred",
+		"accent": "#ff0000",
+		"muted":  "250",
+		"text":   "default",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		p    Palette
+		want Theme
+	}{
+		{Palette{Colors: 8}, Theme{
+			"error": PaletteColor(1), "accent": PaletteColor(1), "muted": PaletteColor(7), "text": {},
+		}},
+		{Palette{Colors: 256}, Theme{
+			"error": PaletteColor(9), "accent": PaletteColor(9), "muted": PaletteColor(250), "text": {},
+		}},
+		{Palette{Colors: 256, Direct: true}, Theme{
+			"error": PaletteColor(9), "accent": RGBColor(255, 0, 0), "muted": PaletteColor(250), "text": {},
+		}},
+		{Palette{}, Theme{
+			"error": {}, "accent": {}, "muted": {}, "text": {},
+		}},
+	}
+	for _, tt := range tests {
+		got := tt.p.MapTheme(theme)
+		for name, c := range tt.want {
+			if got[name] != c {
+				t.Errorf("%+v: %s = %v, want %v", tt.p, name, got[name], c)
+			}
+		}
+	}
+	if r, g, b, ok := (Palette{Colors: 88}).RGB(PaletteColor(79)); !ok || r != 255 || g != 255 || b != 255 {
+		t.Errorf("88 color RGB(79) = %d, %d, %d, %v, want white", r, g, b, ok)
+	}
+}
+
+// xterm256 returns xterm with the color capabilities of xterm-256color,
+// which ncurses 6.1 and later compile in the unsupported 32-bit format.
+func xterm256(t *testing.T) *Terminfo {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti.Names = []string{"xterm-256color", "xterm with 256 colors"}
+	ti.Bools[caps.CanChange] = true
+	ti.Numbers[caps.MaxColors] = 256
+	ti.Numbers[caps.MaxPairs] = 0x7fff
+	ti.Strings[caps.InitializeColor] = "\x1b]4;%p1%d;rgb:" +
+		"%p2%{255}%*%{1000}%/%2.2X/%p3%{255}%*%{1000}%/%2.2X/%p4%{255}%*%{1000}%/%2.2X\x1b\\"
+	ti.Strings[caps.OrigColors] = "\x1b]104\a"
+	ti.Strings[caps.SetABackground] = "\x1b[%?%p1%{8}%<%t4%p1%d%e%p1%{16}%<%t10%p1%{8}%-%d%e48;5;%p1%d%;m"
+	ti.Strings[caps.SetAForeground] = "\x1b[%?%p1%{8}%<%t3%p1%d%e%p1%{16}%<%t9%p1%{8}%-%d%e38;5;%p1%d%;m"
+	return ti
+}
+
+func TestSetColors(t *testing.T) {
+	ti := xterm256(t)
+	if got, want := ti.SetColors(RGBColor(255, 0, 0), Color{}), "\x1b[91m"; got != want {
+		t.Errorf("SetColors = %q, want %q", got, want)
+	}
+	tc := *ti
+	tc.ExtBools = map[string]bool{"Tc": true}
+	if got, want := tc.SetColors(PaletteColor(2), RGBColor(1, 2, 3)), "\x1b[32m\x1b[48;2;1;2;3m"; got != want {
+		t.Errorf("Tc SetColors = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,135 @@
+package terminfo
+
+import (
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// These are the errors returned by PaletteManager.SetColor.
+var (
+	ErrCannotChangeColor = errors.New("terminfo: terminal cannot change colors")
+	ErrColorRange        = errors.New("terminfo: color out of range of the palette")
+)
+
+// CanChangeColor reports whether the colors of the palette can be redefined
+// with initc.
+func (ti *Terminfo) CanChangeColor() bool {
+	return ti.Bools[caps.CanChange] && ti.Strings[caps.InitializeColor] != ""
+}
+
+// PaletteManager redefines colors of a terminal's palette and restores them,
+// for applications that temporarily change the terminal's theme.
+type PaletteManager struct {
+	ti      *Terminfo
+	w       io.Writer
+	changed map[int]bool
+}
+
+// NewPaletteManager returns a PaletteManager for the terminal with the
+// output w.
+func (ti *Terminfo) NewPaletteManager(w io.Writer) *PaletteManager {
+	return &PaletteManager{ti: ti, w: w, changed: make(map[int]bool)}
+}
+
+// SetColor redefines the palette color c as the 24-bit color r, g, b.
+// Components are clamped to 255.
+func (m *PaletteManager) SetColor(c, r, g, b int) error {
+	if !m.ti.CanChangeColor() {
+		return ErrCannotChangeColor
+	}
+	if c < 0 || c >= int(m.ti.Numbers[caps.MaxColors]) {
+		return ErrColorRange
+	}
+	r, g, b = clampByte(r), clampByte(g), clampByte(b)
+	if r < 0 || g < 0 || b < 0 {
+		return ErrColorRange
+	}
+	var p [3]int
+	if m.ti.Bools[caps.HueLightnessSaturation] {
+		p[0], p[1], p[2] = rgbToHLS(r, g, b)
+	} else {
+		// initc takes components from 0 to 1000, rounded up so that they scale
+		// back to the same bytes.
+		p[0], p[1], p[2] = (r*1000+254)/255, (g*1000+254)/255, (b*1000+254)/255
+	}
+	if err := ParmTo(m.w, m.ti.Strings[caps.InitializeColor], c, p[0], p[1], p[2]); err != nil {
+		return err
+	}
+	m.changed[c] = true
+	return nil
+}
+
+// Changed returns the sorted colors redefined since the last Restore.
+func (m *PaletteManager) Changed() []int {
+	cs := make([]int, 0, len(m.changed))
+	for c := range m.changed {
+		cs = append(cs, c)
+	}
+	sort.Ints(cs)
+	return cs
+}
+
+// Restore restores the colors redefined by SetColor. It uses oc to restore
+// the terminal's own palette. Without oc, the colors are set back to those
+// of xterm's default palette, the terminal's original colors being unknown.
+func (m *PaletteManager) Restore() error {
+	if len(m.changed) == 0 {
+		return nil
+	}
+	if oc := m.ti.Strings[caps.OrigColors]; oc != "" {
+		if _, err := io.WriteString(m.w, oc); err != nil {
+			return err
+		}
+		m.changed = make(map[int]bool)
+		return nil
+	}
+	n := paletteSize(int(m.ti.Numbers[caps.MaxColors]))
+	for _, c := range m.Changed() {
+		if c < n {
+			r, g, b := paletteColor(n, c)
+			if err := m.SetColor(c, r, g, b); err != nil {
+				return err
+			}
+		}
+		delete(m.changed, c)
+	}
+	return nil
+}
+
+// rgbToHLS converts a color to the hue (0 to 360), lightness and saturation
+// (0 to 100) taken by initc on terminals with hls. Like in the Tektronix
+// color model, the hue of blue is 0 and that of red is 120.
+func rgbToHLS(r, g, b int) (h, l, s int) {
+	max, min := r, r
+	for _, v := range [2]int{g, b} {
+		if v > max {
+			max = v
+		}
+		if v < min {
+			min = v
+		}
+	}
+	l = (max + min) * 100 / 510
+	d := max - min
+	if d == 0 {
+		return 0, l, 0
+	}
+	if max+min <= 255 {
+		s = d * 100 / (max + min)
+	} else {
+		s = d * 100 / (510 - max - min)
+	}
+	var hf int
+	switch max {
+	case r:
+		hf = 60 * (g - b) / d
+	case g:
+		hf = 60*(b-r)/d + 120
+	default:
+		hf = 60*(r-g)/d + 240
+	}
+	return (hf + 120 + 360) % 360, l, s
+}
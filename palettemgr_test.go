@@ -0,0 +1,74 @@
+package terminfo
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestPaletteManager(t *testing.T) {
+	ti := xterm256(t)
+	if !ti.CanChangeColor() {
+		t.Fatal("xterm-256color can change colors")
+	}
+	var b bytes.Buffer
+	m := ti.NewPaletteManager(&b)
+	if err := m.SetColor(1, 255, 0, 128); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetColor(300, 0, 0, 0); err != ErrColorRange {
+		t.Errorf("SetColor(300) = %v, want ErrColorRange", err)
+	}
+	if got, want := b.String(), "\x1b]4;1;rgb:FF/00/80\x1b\\"; got != want {
+		t.Errorf("SetColor wrote %q, want %q", got, want)
+	}
+	if got := m.Changed(); !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("Changed = %v, want [1]", got)
+	}
+	b.Reset()
+	if err := m.Restore(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), "\x1b]104\a"; got != want {
+		t.Errorf("Restore wrote %q, want %q", got, want)
+	}
+	if len(m.Changed()) != 0 {
+		t.Error("Restore did not forget the changed colors")
+	}
+
+	// Without oc, the default palette is restored.
+	noc := ti.Clone()
+	noc.Strings[caps.OrigColors] = ""
+	m = noc.NewPaletteManager(&b)
+	m.SetColor(196, 0, 0, 0)
+	b.Reset()
+	if err := m.Restore(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), "\x1b]4;196;rgb:FF/00/00\x1b\\"; got != want {
+		t.Errorf("Restore without oc wrote %q, want %q", got, want)
+	}
+
+	vt100, err := Load("vt100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vt100.NewPaletteManager(&b).SetColor(1, 0, 0, 0); err != ErrCannotChangeColor {
+		t.Errorf("vt100 SetColor = %v, want ErrCannotChangeColor", err)
+	}
+}
+
+func TestRGBToHLS(t *testing.T) {
+	for _, tt := range []struct{ r, g, b, h, l, s int }{
+		{0, 0, 255, 0, 50, 100},
+		{255, 0, 0, 120, 50, 100},
+		{0, 255, 0, 240, 50, 100},
+		{255, 255, 255, 0, 100, 0},
+	} {
+		if h, l, s := rgbToHLS(tt.r, tt.g, tt.b); h != tt.h || l != tt.l || s != tt.s {
+			t.Errorf("rgbToHLS(%d, %d, %d) = %d, %d, %d, want %d, %d, %d", tt.r, tt.g, tt.b, h, l, s, tt.h, tt.l, tt.s)
+		}
+	}
+}
@@ -11,21 +11,38 @@ import (
 
 // parametizer represents the scanners state.
 type parametizer struct {
-	s        string          // terminfo string
-	pos      int             // position in s
-	nest     int             // nesting level of if statements
-	stk      stack           // terminfo var stack
-	skipElse bool            // controls which fuction skipText returns
-	buf      *bytes.Buffer   // result buffer
-	params   [9]interface{}  // paramters
-	dvars    [26]interface{} // dynamic vars
-}
-
-// static vars
-var (
-	svarsMutex sync.Mutex
-	svars      [26]interface{}
-)
+	s        string                         // terminfo string
+	pos      int                            // position in s
+	nest     int                            // nesting level of if statements
+	stk      stack                          // terminfo var stack
+	skipElse bool                           // controls which fuction skipText returns
+	buf      *bytes.Buffer                  // result buffer
+	opts     EvalOptions                    // evaluation options
+	nparams  int                            // number of usable parameters
+	strict   bool                           // record evaluation errors in err
+	op       int                            // position of the current operation
+	err      error                          // first evaluation error in strict mode
+	params   [MaxExtendedParams]interface{} // paramters
+	dvars    [26]interface{}                // dynamic vars
+	svars    *[26]interface{}               // static vars, local or from a ParmContext
+	local    [26]interface{}                // static vars of a single evaluation
+}
+
+// numParams is the number of parameters supported by terminfo(5).
+const numParams = 9
+
+// MaxExtendedParams is the number of parameters available when
+// EvalOptions.ExtendedParams is set.
+const MaxExtendedParams = 99
+
+// EvalOptions changes how parameterized strings are evaluated.
+// The zero value evaluates strings the same way as Parm.
+type EvalOptions struct {
+	// ExtendedParams enables multi-digit parameter indices such as %p12,
+	// allowing up to MaxExtendedParams parameters. Without it, %p12 pushes
+	// the first parameter and emits the character 2, as terminfo(5) requires.
+	ExtendedParams bool
+}
 
 var parametizerPool = sync.Pool{
 	New: func() interface{} {
@@ -36,9 +53,15 @@ var parametizerPool = sync.Pool{
 }
 
 // newParametizer returns a new initialized parametizer from the pool.
-func newParametizer(s string) *parametizer {
+func newParametizer(s string, opts EvalOptions) *parametizer {
 	pz := parametizerPool.Get().(*parametizer)
 	pz.s = s
+	pz.opts = opts
+	pz.nparams = numParams
+	pz.svars = &pz.local
+	if opts.ExtendedParams {
+		pz.nparams = MaxExtendedParams
+	}
 	return pz
 }
 
@@ -46,34 +69,107 @@ func newParametizer(s string) *parametizer {
 func (pz *parametizer) free() {
 	pz.pos = 0
 	pz.nest = 0
+	pz.strict = false
+	pz.err = nil
 	pz.stk.reset()
 	pz.buf.Reset()
-	pz.params = [9]interface{}{}
+	for i := range pz.params[:pz.nparams] {
+		pz.params[i] = nil
+	}
 	pz.dvars = [26]interface{}{}
+	pz.local = [26]interface{}{}
+	pz.svars = nil
 	parametizerPool.Put(pz)
 }
 
 // Parm evaluates a terminfo parameterized string, such as caps.SetAForeground,
 // and returns the result.
+//
+// Dynamic variables (%Pa to %Pz) start out unset in every evaluation, like in
+// ncurses. ncurses keeps static variables (%PA to %PZ) from one evaluation to
+// the next, but Parm gives every evaluation its own so that concurrent calls
+// are safe and deterministic. Use a ParmContext to share them between calls.
+//
+// Parameters are numbers of any Go integer type, booleans or strings, for
+// %s and %l. As in tparm, character constants and the results of
+// comparisons are numbers, %c writes 0 as \200 and octal and hexadecimal
+// conversions take 32-bit unsigned numbers.
 func Parm(s string, p ...interface{}) string {
-	pz := newParametizer(s)
+	return ParmOpts(s, EvalOptions{}, p...)
+}
+
+// ParmOpts is like Parm but evaluates s according to opts.
+func ParmOpts(s string, opts EvalOptions, p ...interface{}) string {
+	pz := newParametizer(s, opts)
 	defer pz.free()
-	// make sure we always have 9 parameters -- makes it easier
+	// make sure we always have nparams parameters -- makes it easier
 	// later to skip checks and its faster
-	for i := 0; i < len(pz.params) && i < len(p); i++ {
+	for i := 0; i < pz.nparams && i < len(p); i++ {
 		pz.params[i] = p[i]
 	}
 	return pz.run()
 }
 
+// ParmContext holds the static variables shared by the strings it evaluates,
+// as ncurses does for the strings of a terminal. The zero value is ready to
+// use. It is safe for concurrent use: evaluations are serialized so each one
+// sees the static variables as left by the previous one.
+type ParmContext struct {
+	mu    sync.Mutex
+	svars [26]interface{}
+}
+
+// Parm is like the function Parm, with the static variables of c.
+func (c *ParmContext) Parm(s string, p ...interface{}) string {
+	return c.ParmOpts(s, EvalOptions{}, p...)
+}
+
+// ParmOpts is like the function ParmOpts, with the static variables of c.
+func (c *ParmContext) ParmOpts(s string, opts EvalOptions, p ...interface{}) string {
+	pz := newParametizer(s, opts)
+	defer pz.free()
+	for i := 0; i < pz.nparams && i < len(p); i++ {
+		pz.params[i] = p[i]
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pz.svars = &c.svars
+	return pz.run()
+}
+
+// Reset clears the static variables.
+func (c *ParmContext) Reset() {
+	c.mu.Lock()
+	c.svars = [26]interface{}{}
+	c.mu.Unlock()
+}
+
+// ParmTo is like Parm but writes the result to w. It uses a pooled buffer
+// and does not allocate for small parameters.
+func ParmTo(w io.Writer, s string, p ...interface{}) error {
+	pz := newParametizer(s, EvalOptions{})
+	defer pz.free()
+	for i := 0; i < pz.nparams && i < len(p); i++ {
+		pz.params[i] = p[i]
+	}
+	pz.exec()
+	_, err := w.Write(pz.buf.Bytes())
+	return err
+}
+
 // stateFn represents the state of the scanner as a function that returns the next state.
 type stateFn func(*parametizer) stateFn
 
 func (pz *parametizer) run() string {
+	pz.exec()
+	return pz.buf.String()
+}
+
+// exec evaluates the string into the buffer.
+func (pz *parametizer) exec() {
 	for state := scanText; state != nil; {
 		state = state(pz)
 	}
-	return pz.buf.String()
 }
 
 // get returns the current byte.
@@ -111,6 +207,7 @@ func scanText(pz *parametizer) stateFn {
 }
 
 func scanCode(pz *parametizer) stateFn {
+	pz.op = pz.pos - 1
 	ch, err := pz.get()
 	if err != nil {
 		return nil
@@ -129,24 +226,8 @@ func scanCode(pz *parametizer) stateFn {
 		return scanFormat
 	case '#', ' ', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '.':
 		return scanFormat
-	case 'o':
-		// Special cased from scanFormat for performance.
-		pz.buf.WriteString(strconv.FormatInt(int64(pz.stk.popInt()), 8))
-	case 'd':
-		// Special cased from scanFormat for performance.
-		pz.buf.WriteString(strconv.Itoa(pz.stk.popInt()))
-	case 'x':
-		// Special cased from scanFormat for performance.
-		pz.buf.WriteString(strconv.FormatInt(int64(pz.stk.popInt()), 16))
-	case 'X':
-		// Special cased from scanFormat for performance.
-		pz.buf.WriteString(strings.ToUpper(strconv.FormatInt(int64(pz.stk.popInt()), 16)))
-	case 's':
-		// Special cased from scanFormat for performance.
-		pz.buf.WriteString(pz.stk.popString())
-	case 'c':
-		// Special cased from scanFormat for performance.
-		pz.buf.WriteByte(pz.stk.popByte())
+	case 'o', 'd', 'x', 'X', 's', 'c':
+		pz.format("%"+string(ch), ch)
 	case 'p':
 		pz.pos++
 		return pushParam
@@ -162,80 +243,94 @@ func scanCode(pz *parametizer) stateFn {
 		if err != nil {
 			return nil
 		}
-		pz.stk.push(ch)
+		// Character constants are numbers, like in ncurses.
+		pz.stk.push(int(ch))
 		// skip the '\''
 		pz.pos++
 	case '{':
 		pz.pos++
 		return pushInt
+	case 'l', '+', '-', '*', '/', 'm', '&', '|', '^', '=', '>', '<', 'A', 'O', '!', '~':
+		pz.operate(ch)
+	case 'i':
+		pz.increment()
+	case '?', ';':
+	case 't':
+		return scanThen
+	case 'e':
+		pz.skipElse = true
+		return skipText
+	}
+	pz.pos++
+	return scanText
+}
+
+// operate performs the stack operation ch, such as '+' or '!'.
+func (pz *parametizer) operate(ch byte) {
+	switch ch {
 	case 'l':
-		pz.stk.push(len(pz.stk.popString()))
+		pz.stk.push(len(pz.popString()))
 	case '+':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		pz.stk.push(ai + bi)
 	case '-':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		pz.stk.push(ai - bi)
 	case '*':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		pz.stk.push(ai * bi)
 	case '/':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		if bi != 0 {
 			pz.stk.push(ai / bi)
 		} else {
 			pz.stk.push(0)
 		}
 	case 'm':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		if bi != 0 {
 			pz.stk.push(ai % bi)
 		} else {
 			pz.stk.push(0)
 		}
 	case '&':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		pz.stk.push(ai & bi)
 	case '|':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		pz.stk.push(ai | bi)
 	case '^':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		pz.stk.push(ai ^ bi)
 	case '=':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		pz.stk.push(ai == bi)
 	case '>':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		pz.stk.push(ai > bi)
 	case '<':
-		bi, ai := pz.stk.popInt(), pz.stk.popInt()
+		bi, ai := pz.popInt(), pz.popInt()
 		pz.stk.push(ai < bi)
 	case 'A':
-		bi, ai := pz.stk.popBool(), pz.stk.popBool()
+		bi, ai := pz.popBool(), pz.popBool()
 		pz.stk.push(ai && bi)
 	case 'O':
-		bi, ai := pz.stk.popBool(), pz.stk.popBool()
+		bi, ai := pz.popBool(), pz.popBool()
 		pz.stk.push(ai || bi)
 	case '!':
-		pz.stk.push(!pz.stk.popBool())
+		pz.stk.push(!pz.popBool())
 	case '~':
-		pz.stk.push(^pz.stk.popInt())
-	case 'i':
-		for i := range pz.params[:2] {
-			if n, ok := pz.params[i].(int); ok {
-				pz.params[i] = n + 1
-			}
+		pz.stk.push(^pz.popInt())
+	}
+}
+
+// increment adds one to the first two parameters, for %i.
+func (pz *parametizer) increment() {
+	for i := range pz.params[:2] {
+		if n, ok := number(pz.params[i]); ok {
+			pz.params[i] = n + 1
 		}
-	case '?', ';':
-	case 't':
-		return scanThen
-	case 'e':
-		pz.skipElse = true
-		return skipText
 	}
-	pz.pos++
-	return scanText
 }
 
 func scanFormat(pz *parametizer) stateFn {
@@ -254,14 +349,8 @@ LOOP:
 		}
 		f = append(f, ch)
 		switch ch {
-		case 'o', 'd', 'x', 'X':
-			fmt.Fprintf(pz.buf, string(f), pz.stk.popInt())
-			break LOOP
-		case 's':
-			fmt.Fprintf(pz.buf, string(f), pz.stk.popString())
-			break LOOP
-		case 'c':
-			fmt.Fprintf(pz.buf, string(f), pz.stk.popByte())
+		case 'o', 'd', 'x', 'X', 's', 'c':
+			pz.format(string(f), ch)
 			break LOOP
 		}
 	}
@@ -269,12 +358,50 @@ LOOP:
 	return scanText
 }
 
+// format pops a value for the conversion character verb and writes it
+// to the buffer formatted with f.
+func (pz *parametizer) format(f string, verb byte) {
+	if len(f) == 2 {
+		// Plain conversions are special cased for performance.
+		switch verb {
+		case 'o':
+			pz.buf.WriteString(strconv.FormatUint(uint64(uint32(pz.popInt())), 8))
+		case 'd':
+			pz.buf.WriteString(strconv.Itoa(pz.popInt()))
+		case 'x':
+			pz.buf.WriteString(strconv.FormatUint(uint64(uint32(pz.popInt())), 16))
+		case 'X':
+			pz.buf.WriteString(strings.ToUpper(strconv.FormatUint(uint64(uint32(pz.popInt())), 16)))
+		case 's':
+			pz.buf.WriteString(pz.popString())
+		case 'c':
+			pz.buf.WriteByte(pz.popChar())
+		}
+		return
+	}
+	// Formats follow printf(3) as in ncurses, where octal and hexadecimal
+	// conversions take 32-bit unsigned integers. Flags are ignored for %c.
+	switch verb {
+	case 'd':
+		fmt.Fprintf(pz.buf, f, pz.popInt())
+	case 'o', 'x', 'X':
+		fmt.Fprintf(pz.buf, f, uint32(pz.popInt()))
+	case 's':
+		fmt.Fprintf(pz.buf, f, pz.popString())
+	case 'c':
+		pz.buf.WriteByte(pz.popChar())
+	}
+}
+
 func pushParam(pz *parametizer) stateFn {
 	ch, err := pz.get()
 	if err != nil {
 		return nil
 	}
-	if ai := int(ch - '1'); ai >= 0 && ai < len(pz.params) {
+	if pz.opts.ExtendedParams {
+		return pushExtParam
+	}
+	if ai := int(ch - '1'); ai >= 0 && ai < pz.nparams {
 		pz.stk.push(pz.params[ai])
 	} else {
 		pz.stk.push(0)
@@ -284,20 +411,42 @@ func pushParam(pz *parametizer) stateFn {
 	return scanText
 }
 
+// pushExtParam pushes a parameter with a multi-digit index.
+func pushExtParam(pz *parametizer) stateFn {
+	var ai int
+	for {
+		ch, err := pz.get()
+		if err != nil || ch < '0' || ch > '9' {
+			break
+		}
+		ai = (ai * 10) + int(ch-'0')
+		pz.pos++
+	}
+	if ai >= 1 && ai <= pz.nparams {
+		pz.stk.push(pz.params[ai-1])
+	} else {
+		pz.stk.push(0)
+	}
+	return scanText
+}
+
 func setDSVar(pz *parametizer) stateFn {
 	ch, err := pz.get()
 	if err != nil {
 		return nil
 	}
+	pz.setVar(ch)
+	pz.pos++
+	return scanText
+}
+
+// setVar pops a value into the static (A-Z) or dynamic (a-z) variable ch.
+func (pz *parametizer) setVar(ch byte) {
 	if ch >= 'A' && ch <= 'Z' {
-		svarsMutex.Lock()
-		svars[int(ch-'A')] = pz.stk.pop()
-		svarsMutex.Unlock()
+		pz.svars[int(ch-'A')] = pz.pop()
 	} else if ch >= 'a' && ch <= 'z' {
-		pz.dvars[int(ch-'a')] = pz.stk.pop()
+		pz.dvars[int(ch-'a')] = pz.pop()
 	}
-	pz.pos++
-	return scanText
 }
 
 func getDSVar(pz *parametizer) stateFn {
@@ -305,17 +454,20 @@ func getDSVar(pz *parametizer) stateFn {
 	if err != nil {
 		return nil
 	}
-	var a byte
+	pz.getVar(ch)
+	pz.pos++
+	return scanText
+}
+
+// getVar pushes the value of the static (A-Z) or dynamic (a-z) variable ch.
+func (pz *parametizer) getVar(ch byte) {
 	if ch >= 'A' && ch <= 'Z' {
-		a = 'A'
+		pz.stk.push(pz.svars[int(ch-'A')])
 	} else if ch >= 'a' && ch <= 'z' {
-		a = 'a'
+		pz.stk.push(pz.dvars[int(ch-'a')])
+	} else {
+		pz.stk.push(nil)
 	}
-	svarsMutex.Lock()
-	pz.stk.push(svars[int(ch-a)])
-	svarsMutex.Unlock()
-	pz.pos++
-	return scanText
 }
 
 func pushInt(pz *parametizer) stateFn {
@@ -336,7 +488,7 @@ func pushInt(pz *parametizer) stateFn {
 
 func scanThen(pz *parametizer) stateFn {
 	pz.pos++
-	if pz.stk.popBool() {
+	if pz.popBool() {
 		return scanText
 	}
 	pz.skipElse = false
@@ -400,48 +552,103 @@ func skipElse(pz *parametizer) stateFn {
 	return skipText
 }
 
-// TODO use a special structure
-type stack []interface{}
+// The following pop methods behave like their stack counterparts,
+// but in strict mode record stack underflows and type mismatches in pz.err.
 
-func (stk *stack) push(v interface{}) {
-	*stk = append(*stk, v)
+func (pz *parametizer) pop() interface{} {
+	if pz.strict && len(pz.stk) == 0 {
+		pz.fail(ErrParmUnderflow)
+	}
+	return pz.stk.pop()
 }
 
-func (stk *stack) pop() interface{} {
-	if len(*stk) == 0 {
-		return nil
+func (pz *parametizer) popInt() int {
+	v := pz.pop()
+	ai, ok := number(v)
+	if !ok && pz.strict {
+		pz.fail(ErrParmType)
 	}
-	v := (*stk)[len(*stk)-1]
-	*stk = (*stk)[:len(*stk)-1]
-	return v
+	return ai
+}
+
+func (pz *parametizer) popBool() bool {
+	return pz.popInt() != 0
+}
+
+// popChar pops a number as the character written by %c. Like ncurses, it
+// turns 0 into \200 since strings are null terminated in C.
+func (pz *parametizer) popChar() byte {
+	c := byte(pz.popInt())
+	if c == 0 {
+		c = 0200
+	}
+	return c
 }
 
-func (stk *stack) popInt() int {
-	if ai, ok := stk.pop().(int); ok {
-		return ai
+func (pz *parametizer) popString() string {
+	v := pz.pop()
+	as, ok := v.(string)
+	if !ok && pz.strict {
+		pz.fail(ErrParmType)
 	}
-	return 0
+	return as
 }
 
-func (stk *stack) popBool() bool {
-	if ab, ok := stk.pop().(bool); ok {
-		return ab
+// number returns v as an integer. Numbers of any type, booleans and
+// characters are numbers; ok is false for strings and other values, which
+// are taken as 0 like in ncurses.
+func number(v interface{}) (n int, ok bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case byte:
+		return int(v), true
+	case rune:
+		return int(v), true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case uint:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		return int(v), true
+	case uint64:
+		return int(v), true
 	}
-	return false
+	return 0, false
 }
 
-func (stk *stack) popByte() byte {
-	if ab, ok := stk.pop().(byte); ok {
-		return ab
+// fail records err at the current operation if no error was recorded yet.
+func (pz *parametizer) fail(err error) {
+	if pz.err == nil {
+		pz.err = &ParmError{Offset: pz.op, Err: err}
 	}
-	return 0
 }
 
-func (stk *stack) popString() string {
-	if as, ok := stk.pop().(string); ok {
-		return as
+// TODO use a special structure
+type stack []interface{}
+
+func (stk *stack) push(v interface{}) {
+	*stk = append(*stk, v)
+}
+
+func (stk *stack) pop() interface{} {
+	if len(*stk) == 0 {
+		return nil
 	}
-	return ""
+	v := (*stk)[len(*stk)-1]
+	*stk = (*stk)[:len(*stk)-1]
+	return v
 }
 
 func (stk *stack) reset() {
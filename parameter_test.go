@@ -0,0 +1,274 @@
+package terminfo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestParmExtendedParams(t *testing.T) {
+	p := make([]interface{}, 12)
+	for i := range p {
+		p[i] = i + 1
+	}
+	tests := []struct {
+		s    string
+		opts EvalOptions
+		want string
+	}{
+		{"%p12%d", EvalOptions{}, "21"},
+		{"%p12%d", EvalOptions{ExtendedParams: true}, "12"},
+		{"%p10%d;%p3%d", EvalOptions{ExtendedParams: true}, "10;3"},
+		{"%p1%d", EvalOptions{ExtendedParams: true}, "1"},
+		{"%p100%d", EvalOptions{ExtendedParams: true}, "0"},
+	}
+	for _, tt := range tests {
+		if got := ParmOpts(tt.s, tt.opts, p...); got != tt.want {
+			t.Errorf("ParmOpts(%q, %+v) = %q, want %q", tt.s, tt.opts, got, tt.want)
+		}
+	}
+}
+
+// TestParmConformance compares Parm with the output of tparm of ncurses
+// 6, obtained with Python's curses.tparm. The string cases follow
+// terminfo(5), as curses.tparm only passes numbers.
+func TestParmConformance(t *testing.T) {
+	tests := []struct {
+		s    string
+		p    []interface{}
+		want string
+	}{
+		{"%p1%c%'a'%p2%+%d", []interface{}{0, 5}, "\x80102"},
+		{"%p1%p2%=%{1}%+%d", []interface{}{3, 3}, "2"},
+		{"%'A'%c%'z'%d", nil, "A122"},
+		{"%p1%:-5d|%p1%#x|%p2%#o", []interface{}{3, 4}, "3    |0x3|04"},
+		{"%p1%x|%p1%X|%p1%o|%p1%d", []interface{}{-1}, "ffffffff|FFFFFFFF|37777777777|-1"},
+		{"%p1%5.3d|%p2% d|%p1%#X", []interface{}{42, 7}, "  042| 7|0X2A"},
+		{"%p1%5c|", []interface{}{66}, "B|"},
+		{"%?%p1%p2%>%t>%e<=%;", []interface{}{5, 3}, ">"},
+		{"%p1%!%d%p1%~%d", []interface{}{0}, "1-1"},
+		{"%p1%{10}%/%{48}%+%c%p1%{10}%m%{48}%+%c", []interface{}{73}, "73"},
+		{"%p1%Pa%p2%Pb%ga%gb%*%d", []interface{}{6, 7}, "42"},
+		{"%p1%p2%A%d%p1%p2%O%d", []interface{}{0, 9}, "01"},
+		{"%i%p1%d;%p2%d", []interface{}{0, 0}, "1;1"},
+		{"%p1%'0'%-%d", []interface{}{55}, "7"},
+		{"%p1%l%d|%p1%s|%p1%5.2s|%p1%:-4s|", []interface{}{"hello"}, "5|hello|   he|hello|"},
+		{"%p1%l%d", []interface{}{3}, "0"},
+		{"%p1%d", []interface{}{byte(7)}, "7"},
+		{"\x1b[%i%p1%d;%p2%dH", []interface{}{int16(3), uint8(4)}, "\x1b[4;5H"},
+	}
+	for _, tt := range tests {
+		if got := Parm(tt.s, tt.p...); got != tt.want {
+			t.Errorf("Parm(%q, %v) = %q, want %q", tt.s, tt.p, got, tt.want)
+		}
+		prog, err := CompileParm(tt.s)
+		if err != nil {
+			t.Errorf("CompileParm(%q): %v", tt.s, err)
+			continue
+		}
+		if got := prog.Eval(tt.p...); got != tt.want {
+			t.Errorf("CompileParm(%q).Eval(%v) = %q, want %q", tt.s, tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestParmErr(t *testing.T) {
+	tests := []struct {
+		s      string
+		p      []interface{}
+		want   string
+		err    error
+		offset int
+	}{
+		{"\x1b[%i%p1%d;%p2%dH", []interface{}{1, 2}, "\x1b[2;3H", nil, 0},
+		{"%?%p1%t1%e0%;", []interface{}{true}, "1", nil, 0},
+		{"%?%p1%{8}%<%t3%p1%d%e%p1%{16}%<%t9%p1%{8}%-%d%;", []interface{}{9}, "91", nil, 0},
+		{"%p1%:-3d|", []interface{}{7}, "7  |", nil, 0},
+		{"ab%", nil, "", ErrParmUnterminated, 2},
+		{"%p1%z", []interface{}{1}, "", ErrParmUnknownOp, 3},
+		{"%p0%d", nil, "", ErrParmBadParam, 0},
+		{"%P1", nil, "", ErrParmBadVar, 0},
+		{"%{12", nil, "", ErrParmBadConst, 0},
+		{"%?%p1%t1", []interface{}{true}, "", ErrParmUnbalanced, 8},
+		{"x%;", nil, "", ErrParmUnbalanced, 1},
+		{"%d", nil, "", ErrParmUnderflow, 0},
+		{"%p1%p2%+%d", []interface{}{1, "a"}, "", ErrParmType, 6},
+	}
+	for _, tt := range tests {
+		got, err := ParmErr(tt.s, tt.p...)
+		if tt.err == nil {
+			if err != nil || got != tt.want {
+				t.Errorf("ParmErr(%q) = %q, %v; want %q", tt.s, got, err, tt.want)
+			}
+			continue
+		}
+		perr, ok := err.(*ParmError)
+		if !ok || perr.Err != tt.err || perr.Offset != tt.offset {
+			t.Errorf("ParmErr(%q) error = %v; want %v at offset %d", tt.s, err, tt.err, tt.offset)
+		}
+	}
+}
+
+func TestParmProgram(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := [][]interface{}{
+		{},
+		{3, 7},
+		{12, 250, 1, 0, 1, 0, 1, 0, 1},
+		{true, false, true, false, true, false, true, false, true},
+	}
+	strs := append(ti.Strings[:], "%?%p1%t%p2%d%e%p3%d%;|%'A'%c%{65}%X%p1%:-4d|")
+	for _, s := range strs {
+		prog, err := CompileParm(s)
+		if err != nil {
+			if _, perr := ParmErr(s); perr == nil {
+				t.Errorf("CompileParm(%q): %v", s, err)
+			}
+			continue
+		}
+		for _, p := range params {
+			want := Parm(s, p...)
+			if got := prog.Eval(p...); got != want {
+				t.Errorf("CompileParm(%q).Eval(%v) = %q, want %q", s, p, got, want)
+			}
+		}
+	}
+}
+
+func TestParmCached(t *testing.T) {
+	ti := &Terminfo{}
+	ti.Strings[caps.SetAForeground] = "\x1b[%?%p1%{8}%<%t3%p1%d%e38;5;%p1%d%;m"
+	for n := 0; n < ParmCacheSize+10; n++ {
+		if got, want := ti.ParmCached(caps.SetAForeground, n), Parm(ti.Strings[caps.SetAForeground], n); got != want {
+			t.Fatalf("ParmCached(setaf, %d) = %q, want %q", n, got, want)
+		}
+	}
+	if n := ti.parms.lru.Len(); n != ParmCacheSize {
+		t.Errorf("cache holds %d results, want %d", n, ParmCacheSize)
+	}
+	ti.Strings[caps.SetAForeground] = "\x1b[3%p1%dm"
+	if got := ti.ParmCached(caps.SetAForeground, 1); got != "\x1b[31m" {
+		t.Errorf("changed string: got %q", got)
+	}
+	if got := ti.ParmCached(caps.SetAForeground, []int{1}); got != "\x1b[30m" {
+		t.Errorf("uncacheable parameter: got %q", got)
+	}
+	if ti.Clone().parms != nil {
+		t.Error("Clone shares the cache")
+	}
+
+	// Goroutines racing to create the cache share one, run with -race.
+	ti = ti.Clone()
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 10; n++ {
+				ti.ParmCached(caps.SetAForeground, n)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := ti.parms.lru.Len(); n != 10 {
+		t.Errorf("cache holds %d results, want 10", n)
+	}
+}
+
+func BenchmarkParmCached(b *testing.B) {
+	ti := &Terminfo{}
+	ti.Strings[caps.SetAForeground] = "\x1b[%?%p1%{8}%<%t3%p1%d%e%p1%{16}%<%t9%p1%{8}%-%d%e38;5;%p1%d%;m"
+	var r string
+	for i := 0; i < b.N; i++ {
+		r = ti.ParmCached(caps.SetAForeground, i%16)
+	}
+	result = r
+}
+
+func BenchmarkParmGoto(b *testing.B) {
+	const cup = "\x1b[%i%p1%d;%p2%dH"
+	var r string
+	for i := 0; i < b.N; i++ {
+		r = Parm(cup, i%50, i%80)
+	}
+	result = r
+}
+
+func BenchmarkParmProgramGoto(b *testing.B) {
+	prog, err := CompileParm("\x1b[%i%p1%d;%p2%dH")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var r string
+	for i := 0; i < b.N; i++ {
+		r = prog.Eval(i%50, i%80)
+	}
+	result = r
+}
+
+func BenchmarkParmToGoto(b *testing.B) {
+	const cup = "\x1b[%i%p1%d;%p2%dH"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ParmTo(ioutil.Discard, cup, i%50, i%80)
+	}
+}
+
+func TestParmToAllocs(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Warm up the pool.
+	ti.GotoTo(ioutil.Discard, 1, 1)
+	for name, f := range map[string]func(){
+		"GotoTo":  func() { ti.GotoTo(ioutil.Discard, 10, 20) },
+		"ColorTo": func() { ti.ColorTo(ioutil.Discard, 1, 2) },
+	} {
+		if n := testing.AllocsPerRun(100, f); n != 0 && !raceEnabled {
+			t.Errorf("%s allocates %v times per run", name, n)
+		}
+	}
+	var b bytes.Buffer
+	if ti.GotoTo(&b, 10, 20); b.String() != ti.Goto(10, 20) {
+		t.Errorf("GotoTo wrote %q, want %q", b.String(), ti.Goto(10, 20))
+	}
+}
+
+func TestParmContext(t *testing.T) {
+	const set, get = "%p1%PA", "%gA%d"
+	Parm(set, 5)
+	if got := Parm(get); got != "0" {
+		t.Errorf("Parm shared a static variable: got %q", got)
+	}
+	var c ParmContext
+	c.Parm(set, 5)
+	if got := c.Parm(get); got != "5" {
+		t.Errorf("ParmContext lost a static variable: got %q", got)
+	}
+	c.Reset()
+	if got := c.Parm(get); got != "0" {
+		t.Errorf("Reset kept a static variable: got %q", got)
+	}
+	done := make(chan bool)
+	for i := 0; i < 4; i++ {
+		go func(i int) {
+			for j := 0; j < 100; j++ {
+				if got, want := Parm("%p1%PA%gA%d", i), strconv.Itoa(i); got != want {
+					t.Errorf("concurrent Parm = %q, want %q", got, want)
+				}
+			}
+			done <- true
+		}(i)
+	}
+	for i := 0; i < 4; i++ {
+		<-done
+	}
+}
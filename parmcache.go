@@ -0,0 +1,108 @@
+package terminfo
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// ParmCacheSize is the number of results ParmCached keeps for each entry.
+const ParmCacheSize = 256
+
+// parmKey identifies a result of ParmCached. It holds the string rather than
+// its index so that changing the entry never returns stale results.
+type parmKey struct {
+	s string
+	n int
+	p [numParams]interface{}
+}
+
+// parmResult is an element of the lru list of a parmCache.
+type parmResult struct {
+	key parmKey
+	s   string
+}
+
+// parmCache holds the most recently used results of ParmCached.
+type parmCache struct {
+	mu      sync.Mutex
+	results map[parmKey]*list.Element
+	lru     list.List // of *parmResult, most recently used first
+}
+
+// ParmCached is like Parm but remembers the results of the ParmCacheSize
+// most recently used combinations of string and parameters, so rendering
+// loops switching between a few colors or attributes do not evaluate the
+// same strings over and over. Results are only cached for parameters that
+// are numbers, booleans or strings. It is safe for concurrent use, and calls
+// on different entries do not contend.
+func (ti *Terminfo) ParmCached(i int, p ...interface{}) string {
+	s := ti.str(i)
+	if s == "" {
+		return ""
+	}
+	key, ok := newParmKey(s, p)
+	if !ok {
+		return Parm(s, p...)
+	}
+	c := ti.parmCache()
+	c.mu.Lock()
+	if e, ok := c.results[key]; ok {
+		c.lru.MoveToFront(e)
+		r := e.Value.(*parmResult).s
+		c.mu.Unlock()
+		return r
+	}
+	c.mu.Unlock()
+	r := Parm(s, p...)
+	c.add(key, r)
+	return r
+}
+
+// parmCache returns the parmCache of ti, creating it if needed. It is
+// created without a lock; if goroutines race to create it, the first one
+// stored is used by all of them.
+func (ti *Terminfo) parmCache() *parmCache {
+	p := (*unsafe.Pointer)(unsafe.Pointer(&ti.parms))
+	if c := atomic.LoadPointer(p); c != nil {
+		return (*parmCache)(c)
+	}
+	c := &parmCache{results: make(map[parmKey]*list.Element)}
+	atomic.CompareAndSwapPointer(p, nil, unsafe.Pointer(c))
+	return (*parmCache)(atomic.LoadPointer(p))
+}
+
+// newParmKey returns the key of the string evaluated with the parameters,
+// reporting false if a parameter cannot be part of a key.
+func newParmKey(s string, p []interface{}) (parmKey, bool) {
+	key := parmKey{s: s, n: len(p)}
+	if key.n > numParams {
+		key.n = numParams
+	}
+	for i := 0; i < key.n; i++ {
+		switch p[i].(type) {
+		case int, bool, string, byte, rune, int8, int16, int64, uint, uint16, uint32, uint64:
+		default:
+			return parmKey{}, false
+		}
+		key.p[i] = p[i]
+	}
+	return key, true
+}
+
+// add caches the result r, evicting the least recently used result if the
+// cache is full.
+func (c *parmCache) add(key parmKey, r string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.results[key]; ok {
+		return
+	}
+	c.results[key] = c.lru.PushFront(&parmResult{key, r})
+	if c.lru.Len() > ParmCacheSize {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		delete(c.results, e.Value.(*parmResult).key)
+	}
+}
@@ -0,0 +1,179 @@
+package terminfo
+
+import (
+	"errors"
+	"strconv"
+)
+
+// These are the errors reported by ParmErr, wrapped in a *ParmError.
+var (
+	ErrParmUnterminated = errors.New("terminfo: unterminated % sequence")
+	ErrParmUnknownOp    = errors.New("terminfo: unknown % operation")
+	ErrParmBadParam     = errors.New("terminfo: bad parameter index")
+	ErrParmBadVar       = errors.New("terminfo: bad variable name")
+	ErrParmBadConst     = errors.New("terminfo: bad constant")
+	ErrParmBadFormat    = errors.New("terminfo: bad format specification")
+	ErrParmUnbalanced   = errors.New("terminfo: unbalanced conditional")
+	ErrParmUnderflow    = errors.New("terminfo: stack underflow")
+	ErrParmType         = errors.New("terminfo: type mismatch")
+)
+
+// ParmError records an error in a parameterized string and the byte offset
+// of the % operation that caused it.
+type ParmError struct {
+	Offset int
+	Err    error
+}
+
+func (e *ParmError) Error() string {
+	return e.Err.Error() + " at offset " + strconv.Itoa(e.Offset)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParmError) Unwrap() error {
+	return e.Err
+}
+
+// ParmErr is like Parm, but validates the entire parameterized string
+// and returns an error describing the first problem encountered.
+// Unlike Parm, it reports malformed or unbalanced % operations, stack
+// underflows and operands of the wrong type, which Parm silently ignores.
+// When the error is nil, the result is identical to that of Parm.
+func ParmErr(s string, p ...interface{}) (string, error) {
+	return parmErr(s, EvalOptions{}, p...)
+}
+
+// parmErr checks s for syntax errors before evaluating it in strict mode.
+func parmErr(s string, opts EvalOptions, p ...interface{}) (string, error) {
+	if err := checkParm(s, opts); err != nil {
+		return "", err
+	}
+	pz := newParametizer(s, opts)
+	defer pz.free()
+	pz.strict = true
+	for i := 0; i < pz.nparams && i < len(p); i++ {
+		pz.params[i] = p[i]
+	}
+	rv := pz.run()
+	if pz.err != nil {
+		return "", pz.err
+	}
+	return rv, nil
+}
+
+// Conditional states used by checkParm.
+const (
+	condIf   = iota // after %?, expecting %t
+	condThen        // after %t, expecting %e or %;
+	condElse        // after %e, expecting %t or %;
+)
+
+// checkParm validates the syntax of s without evaluating it.
+func checkParm(s string, opts EvalOptions) error {
+	var conds []int
+	fail := func(off int, err error) error {
+		return &ParmError{Offset: off, Err: err}
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			continue
+		}
+		op := i
+		i++
+		if i >= len(s) {
+			return fail(op, ErrParmUnterminated)
+		}
+		switch ch := s[i]; ch {
+		case '%', 'c', 'd', 'o', 'x', 'X', 's', 'l', '+', '-', '*', '/', 'm',
+			'&', '|', '^', '=', '>', '<', 'A', 'O', '!', '~', 'i':
+		case 'p':
+			i++
+			start := i
+			for i < len(s) && s[i] >= '0' && s[i] <= '9' && (opts.ExtendedParams || i == start) {
+				i++
+			}
+			n, err := strconv.Atoi(s[start:i])
+			if err != nil || n < 1 || (opts.ExtendedParams && n > MaxExtendedParams) {
+				return fail(op, ErrParmBadParam)
+			}
+			i--
+		case 'P', 'g':
+			i++
+			if i >= len(s) || !(s[i] >= 'a' && s[i] <= 'z' || s[i] >= 'A' && s[i] <= 'Z') {
+				return fail(op, ErrParmBadVar)
+			}
+		case '\'':
+			if i+2 >= len(s) || s[i+2] != '\'' {
+				return fail(op, ErrParmBadConst)
+			}
+			i += 2
+		case '{':
+			i++
+			start := i
+			for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+				i++
+			}
+			if i == start || i >= len(s) || s[i] != '}' {
+				return fail(op, ErrParmBadConst)
+			}
+		case ':', '#', ' ', '.', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			end, ok := scanFormatSpec(s, i)
+			if !ok {
+				return fail(op, ErrParmBadFormat)
+			}
+			i = end
+		case '?':
+			conds = append(conds, condIf)
+		case 't':
+			if n := len(conds); n == 0 || conds[n-1] == condThen {
+				return fail(op, ErrParmUnbalanced)
+			}
+			conds[len(conds)-1] = condThen
+		case 'e':
+			if n := len(conds); n == 0 || conds[n-1] != condThen {
+				return fail(op, ErrParmUnbalanced)
+			}
+			conds[len(conds)-1] = condElse
+		case ';':
+			if n := len(conds); n == 0 || conds[n-1] == condIf {
+				return fail(op, ErrParmUnbalanced)
+			}
+			conds = conds[:len(conds)-1]
+		default:
+			return fail(op, ErrParmUnknownOp)
+		}
+	}
+	if len(conds) > 0 {
+		return fail(len(s), ErrParmUnbalanced)
+	}
+	return nil
+}
+
+// scanFormatSpec scans a printf style format specification of the form
+// [:]flags[width[.precision]][doxXs] starting at i and returns the position
+// of the conversion character.
+func scanFormatSpec(s string, i int) (end int, ok bool) {
+	if s[i] == ':' {
+		i++
+	}
+	for i < len(s) && (s[i] == '-' || s[i] == '+' || s[i] == '#' || s[i] == ' ') {
+		i++
+	}
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+	}
+	if i >= len(s) {
+		return i, false
+	}
+	switch s[i] {
+	case 'd', 'o', 'x', 'X', 's', 'c':
+		return i, true
+	}
+	return i, false
+}
@@ -0,0 +1,187 @@
+package terminfo
+
+import (
+	"io"
+	"strconv"
+)
+
+// opcode is the operation performed by an instruction of a ParmProgram.
+type opcode uint8
+
+const (
+	opText      opcode = iota // write s
+	opParam                   // push parameter arg
+	opInt                     // push the integer arg
+	opChar                    // push the character arg
+	opSetVar                  // pop into the variable arg
+	opGetVar                  // push the variable arg
+	opOperate                 // perform the stack operation arg
+	opFormat                  // pop and write with the format s and conversion arg
+	opIncrement               // increment the first two parameters
+	opThen                    // pop and jump to arg if false
+	opJump                    // jump to arg
+)
+
+// instruction is a single step of a ParmProgram.
+type instruction struct {
+	op  opcode
+	arg int
+	s   string
+}
+
+// ParmProgram is a parameterized string compiled by CompileParm.
+// It is safe for concurrent use.
+type ParmProgram struct {
+	instrs []instruction
+}
+
+// CompileParm parses the parameterized string s once so that it can be
+// evaluated repeatedly without scanning it again. The program evaluates
+// to the same result as Parm. An error is returned if s is malformed,
+// as reported by ParmErr.
+func CompileParm(s string) (*ParmProgram, error) {
+	if err := checkParm(s, EvalOptions{}); err != nil {
+		return nil, err
+	}
+	prog := new(ParmProgram)
+	// The conditionals being compiled. Each holds the position of the
+	// pending %t jump and of the %e jumps to the end of the conditional.
+	type cond struct {
+		then int
+		ends []int
+	}
+	var conds []cond
+	emit := func(op opcode, arg int, s string) {
+		prog.instrs = append(prog.instrs, instruction{op: op, arg: arg, s: s})
+	}
+	text := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			continue
+		}
+		if text < i {
+			emit(opText, 0, s[text:i])
+		}
+		i++
+		switch ch := s[i]; ch {
+		case '%':
+			emit(opText, 0, "%")
+		case 'd', 'o', 'x', 'X', 's', 'c':
+			emit(opFormat, int(ch), "%"+string(ch))
+		case 'p':
+			i++
+			emit(opParam, int(s[i]-'1'), "")
+		case 'P':
+			i++
+			emit(opSetVar, int(s[i]), "")
+		case 'g':
+			i++
+			emit(opGetVar, int(s[i]), "")
+		case '\'':
+			emit(opChar, int(s[i+1]), "")
+			i += 2
+		case '{':
+			end := i + 1
+			for s[end] != '}' {
+				end++
+			}
+			n, _ := strconv.Atoi(s[i+1 : end])
+			emit(opInt, n, "")
+			i = end
+		case ':', '#', ' ', '.', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			start := i
+			if ch == ':' {
+				start++
+			}
+			i, _ = scanFormatSpec(s, i)
+			emit(opFormat, int(s[i]), "%"+s[start:i+1])
+		case 'i':
+			emit(opIncrement, 0, "")
+		case '?':
+			conds = append(conds, cond{then: -1})
+		case 't':
+			conds[len(conds)-1].then = len(prog.instrs)
+			emit(opThen, 0, "")
+		case 'e':
+			c := &conds[len(conds)-1]
+			c.ends = append(c.ends, len(prog.instrs))
+			emit(opJump, 0, "")
+			prog.instrs[c.then].arg = len(prog.instrs)
+			c.then = -1
+		case ';':
+			c := conds[len(conds)-1]
+			conds = conds[:len(conds)-1]
+			if c.then != -1 {
+				prog.instrs[c.then].arg = len(prog.instrs)
+			}
+			for _, j := range c.ends {
+				prog.instrs[j].arg = len(prog.instrs)
+			}
+		default:
+			emit(opOperate, int(ch), "")
+		}
+		text = i + 1
+	}
+	if text < len(s) {
+		emit(opText, 0, s[text:])
+	}
+	return prog, nil
+}
+
+// Eval evaluates the program with the parameters and returns the result.
+func (prog *ParmProgram) Eval(p ...interface{}) string {
+	pz := prog.exec(p)
+	defer pz.free()
+	return pz.buf.String()
+}
+
+// EvalTo evaluates the program with the parameters and writes the result to w.
+func (prog *ParmProgram) EvalTo(w io.Writer, p ...interface{}) error {
+	pz := prog.exec(p)
+	defer pz.free()
+	_, err := w.Write(pz.buf.Bytes())
+	return err
+}
+
+// exec runs the program and returns the parametizer holding the result.
+// The caller must free it.
+func (prog *ParmProgram) exec(p []interface{}) *parametizer {
+	pz := newParametizer("", EvalOptions{})
+	for i := 0; i < pz.nparams && i < len(p); i++ {
+		pz.params[i] = p[i]
+	}
+	for pc := 0; pc < len(prog.instrs); pc++ {
+		in := &prog.instrs[pc]
+		switch in.op {
+		case opText:
+			pz.buf.WriteString(in.s)
+		case opParam:
+			if in.arg >= 0 && in.arg < pz.nparams {
+				pz.stk.push(pz.params[in.arg])
+			} else {
+				pz.stk.push(0)
+			}
+		case opInt:
+			pz.stk.push(in.arg)
+		case opChar:
+			pz.stk.push(in.arg)
+		case opSetVar:
+			pz.setVar(byte(in.arg))
+		case opGetVar:
+			pz.getVar(byte(in.arg))
+		case opOperate:
+			pz.operate(byte(in.arg))
+		case opFormat:
+			pz.format(in.s, byte(in.arg))
+		case opIncrement:
+			pz.increment()
+		case opThen:
+			if !pz.popBool() {
+				pc = in.arg - 1
+			}
+		case opJump:
+			pc = in.arg - 1
+		}
+	}
+	return pz
+}
@@ -0,0 +1,213 @@
+package terminfo
+
+import (
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultProbeCaps are the capabilities queried by Probe by default. They
+// describe truecolor, bracketed paste, cursor styles, styled underlines and
+// synchronized output, which databases often get wrong.
+var DefaultProbeCaps = []string{
+	"Tc", "RGB", "setrgbf", "setrgbb", "colors",
+	"BE", "BD", "PS", "PE",
+	"Ss", "Se", "Smulx", "Setulc", "Sync",
+}
+
+// KittyKeyboardCap is the extended boolean capability set by
+// ProbeResult.Apply when the terminal supports the kitty keyboard protocol.
+// It is the name used by the kitty entry.
+const KittyKeyboardCap = "fullkbd"
+
+// ErrProbeTimeout is returned by Probe when the terminal did not answer the
+// device attributes query in time.
+var ErrProbeTimeout = errors.New("terminfo: terminal did not answer the probe")
+
+// ProbeOptions controls Probe.
+type ProbeOptions struct {
+	// Caps are the capabilities to query with XTGETTCAP. DefaultProbeCaps
+	// is used if it is nil.
+	Caps []string
+	// Timeout is how long to wait for the answers if the ReadWriter has a
+	// SetReadDeadline method, such as an *os.File of a terminal. It
+	// defaults to one second.
+	Timeout time.Duration
+}
+
+// ProbeResult holds the answers of a terminal to Probe.
+type ProbeResult struct {
+	// PrimaryDA and SecondaryDA are the parameters of the primary and
+	// secondary device attributes reports.
+	PrimaryDA, SecondaryDA []int
+	// Caps are the capabilities reported by XTGETTCAP. Booleans have an
+	// empty value.
+	Caps map[string]string
+	// KittyKeyboard reports whether the terminal answered the kitty
+	// keyboard protocol query.
+	KittyKeyboard bool
+}
+
+// Probe queries the terminal connected to rw for its device attributes, the
+// kitty keyboard protocol and the capabilities of opts.Caps with XTGETTCAP.
+// The terminal must be in raw mode so the answers are not echoed nor line
+// buffered. Every terminal answers the primary device attributes query,
+// which is sent last so Probe knows when it has all the answers.
+// Input other than the answers, such as keys typed meanwhile, is discarded.
+func Probe(rw io.ReadWriter, opts ProbeOptions) (*ProbeResult, error) {
+	if opts.Caps == nil {
+		opts.Caps = DefaultProbeCaps
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = time.Second
+	}
+	var q strings.Builder
+	for _, name := range opts.Caps {
+		q.WriteString(DCS(nil, "+q", hex.EncodeToString([]byte(name))))
+	}
+	q.WriteString(PrivateCSI('?', nil, "u"))
+	q.WriteString(PrivateCSI('>', nil, "c"))
+	q.WriteString(CSI(nil, "c"))
+	if _, err := io.WriteString(rw, q.String()); err != nil {
+		return nil, err
+	}
+	if d, ok := rw.(interface{ SetReadDeadline(time.Time) error }); ok {
+		if err := d.SetReadDeadline(time.Now().Add(opts.Timeout)); err == nil {
+			defer d.SetReadDeadline(time.Time{})
+		}
+	}
+	r := &ProbeResult{Caps: make(map[string]string)}
+	var buf []byte
+	b := make([]byte, 256)
+	for {
+		n, err := rw.Read(b)
+		buf = append(buf, b[:n]...)
+		var done bool
+		buf, done = r.parse(buf)
+		if done {
+			return r, nil
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, os.ErrDeadlineExceeded) {
+				err = ErrProbeTimeout
+			}
+			return r, err
+		}
+	}
+}
+
+// parse records the complete answers at the start of buf and returns the
+// rest. done is true once the primary device attributes were read.
+func (r *ProbeResult) parse(buf []byte) (rest []byte, done bool) {
+	for len(buf) > 0 {
+		if buf[0] != '\x1b' {
+			buf = buf[1:]
+			continue
+		}
+		if len(buf) < 2 {
+			return buf, false
+		}
+		switch buf[1] {
+		case 'P':
+			end := strings.Index(string(buf), st)
+			if end == -1 {
+				return buf, false
+			}
+			r.parseTcap(string(buf[2:end]))
+			buf = buf[end+len(st):]
+		case '[':
+			end := 2
+			for end < len(buf) && (buf[end] < 0x40 || buf[end] > 0x7e) {
+				end++
+			}
+			if end == len(buf) {
+				return buf, false
+			}
+			seq := string(buf[2:end])
+			final := buf[end]
+			buf = buf[end+1:]
+			switch {
+			case final == 'u' && strings.HasPrefix(seq, "?"):
+				r.KittyKeyboard = true
+			case final == 'c' && strings.HasPrefix(seq, ">"):
+				r.SecondaryDA = parseParams(seq[1:])
+			case final == 'c' && strings.HasPrefix(seq, "?"):
+				r.PrimaryDA = parseParams(seq[1:])
+				return buf, true
+			}
+		default:
+			buf = buf[1:]
+		}
+	}
+	return buf, false
+}
+
+// parseTcap records an XTGETTCAP answer such as 1+r5463 or
+// 1+r636f6c6f7273=323536.
+func (r *ProbeResult) parseTcap(s string) {
+	if !strings.HasPrefix(s, "1+r") {
+		return
+	}
+	name, value := s[3:], ""
+	if i := strings.IndexByte(name, '='); i >= 0 {
+		name, value = name[:i], name[i+1:]
+	}
+	n, err := hex.DecodeString(name)
+	if err != nil || len(n) == 0 {
+		return
+	}
+	v, err := hex.DecodeString(value)
+	if err != nil {
+		return
+	}
+	r.Caps[string(n)] = string(v)
+}
+
+// parseParams parses semicolon separated numeric parameters. Empty or
+// malformed parameters are -1.
+func parseParams(s string) []int {
+	if s == "" {
+		return nil
+	}
+	fields := strings.Split(s, ";")
+	params := make([]int, len(fields))
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			n = -1
+		}
+		params[i] = n
+	}
+	return params
+}
+
+// Apply returns a copy of ti with the capabilities reported by the terminal.
+// Standard capabilities are parsed according to their type. Others are set
+// as extended capabilities: booleans if they have no value, numbers if the
+// value is a decimal number and strings otherwise. KittyKeyboardCap is set
+// if the terminal supports the kitty keyboard protocol.
+func (r *ProbeResult) Apply(ti *Terminfo) *Terminfo {
+	nti := ti.Clone()
+	for name, value := range r.Caps {
+		c := Capability{Kind: CapString, Present: true, Str: value}
+		n, err := strconv.Atoi(value)
+		switch kind := nti.Get(name).Kind; {
+		case kind == CapBool || kind == CapUnknown && value == "":
+			c = Capability{Kind: CapBool, Present: true, Bool: true}
+		case kind == CapNumber || kind == CapUnknown && err == nil:
+			if err != nil {
+				continue
+			}
+			c = Capability{Kind: CapNumber, Present: true, Num: n}
+		}
+		nti.Set(name, c)
+	}
+	if r.KittyKeyboard {
+		nti.Set(KittyKeyboardCap, Capability{Kind: CapBool, Present: true, Bool: true})
+	}
+	return nti
+}
@@ -0,0 +1,72 @@
+package terminfo
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// fakeTTY answers with canned input and records the output.
+type fakeTTY struct {
+	in  io.Reader
+	out bytes.Buffer
+}
+
+func (f *fakeTTY) Read(b []byte) (int, error) {
+	return f.in.Read(b)
+}
+
+func (f *fakeTTY) Write(b []byte) (int, error) {
+	return f.out.Write(b)
+}
+
+func TestProbe(t *testing.T) {
+	tty := &fakeTTY{in: strings.NewReader("x" +
+		"\x1bP1+r5463\x1b\\" +
+		"\x1bP1+r636f6c6f7273=3136373737323136\x1b\\" +
+		"\x1bP0+r4245\x1b\\" +
+		"\x1bP1+r5373=1b5b25703125642071\x1b\\" +
+		"\x1b[?0u" +
+		"\x1b[>41;380;0c" +
+		"\x1b[?64;1;2c" +
+		"late")}
+	r, err := Probe(tty, ProbeOptions{Caps: []string{"Tc", "colors", "BE", "Ss"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "\x1bP+q5463\x1b\\\x1bP+q636f6c6f7273\x1b\\\x1bP+q4245\x1b\\\x1bP+q5373\x1b\\\x1b[?u\x1b[>c\x1b[c"
+	if got := tty.out.String(); got != want {
+		t.Errorf("queries = %q, want %q", got, want)
+	}
+	if !reflect.DeepEqual(r.PrimaryDA, []int{64, 1, 2}) || !reflect.DeepEqual(r.SecondaryDA, []int{41, 380, 0}) {
+		t.Errorf("DA = %v, %v", r.PrimaryDA, r.SecondaryDA)
+	}
+	wantCaps := map[string]string{"Tc": "", "colors": "16777216", "Ss": "\x1b[%p1%d q"}
+	if !reflect.DeepEqual(r.Caps, wantCaps) || !r.KittyKeyboard {
+		t.Errorf("Caps = %q, KittyKeyboard = %v", r.Caps, r.KittyKeyboard)
+	}
+
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nti := r.Apply(ti)
+	if !nti.ExtBools["Tc"] || !nti.ExtBools[KittyKeyboardCap] || nti.ExtStrings["Ss"] != "\x1b[%p1%d q" {
+		t.Errorf("extended capabilities were not applied: %v %v", nti.ExtBools, nti.ExtStrings)
+	}
+	if nti.Numbers[caps.MaxColors] != 32767 {
+		t.Errorf("colors = %d", nti.Numbers[caps.MaxColors])
+	}
+	if ti.ExtBools["Tc"] || ti.Numbers[caps.MaxColors] != 8 {
+		t.Error("Apply modified the entry")
+	}
+
+	tty = &fakeTTY{in: strings.NewReader("\x1b[>1;2c")}
+	if _, err = Probe(tty, ProbeOptions{}); err != ErrProbeTimeout {
+		t.Errorf("got %v, want ErrProbeTimeout", err)
+	}
+}
@@ -0,0 +1,49 @@
+package terminfo
+
+// Origin describes a source a Terminfo was built from.
+type Origin struct {
+	// Kind is how the source was read: "file" for compiled entries,
+	// "source" for entries parsed by ParseSource, "termcap" for entries
+	// parsed by ParseTermcap, "compose" for entries created by Compose and
+	// "entry" for entries of unknown origin.
+	Kind string
+	// Name is the path of the file or the name of the entry.
+	Name string
+}
+
+func (o Origin) String() string {
+	return o.Kind + " " + o.Name
+}
+
+// Explain returns the origin of the capability with the short name, standard
+// or extended. ok is false if the origins of ti are unknown.
+func (ti *Terminfo) Explain(name string) (o Origin, ok bool) {
+	if len(ti.Origins) == 0 {
+		return o, false
+	}
+	return ti.Origins[ti.CapOrigins[name]], true
+}
+
+// addOrigins appends the origins of src to the chain of ti and returns the
+// index of the first one.
+func (ti *Terminfo) addOrigins(src *Terminfo) int {
+	if ti.CapOrigins == nil {
+		ti.CapOrigins = make(map[string]int)
+	}
+	off := len(ti.Origins)
+	if len(src.Origins) == 0 {
+		o := Origin{Kind: "entry"}
+		if len(src.Names) > 0 {
+			o.Name = src.Names[0]
+		}
+		ti.Origins = append(ti.Origins, o)
+	}
+	ti.Origins = append(ti.Origins, src.Origins...)
+	return off
+}
+
+// setOrigin records that the capability with the short name was taken from
+// src, whose origins start at off in the chain of ti.
+func (ti *Terminfo) setOrigin(name string, src *Terminfo, off int) {
+	ti.CapOrigins[name] = off + src.CapOrigins[name]
+}
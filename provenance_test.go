@@ -0,0 +1,46 @@
+package terminfo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	tis, err := ParseSource(strings.NewReader(testSource + "test-top|top entry,\n\tbel=^H, use=test-derived,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti := tis[2]
+	want := []Origin{{"source", "test-top"}, {"source", "test-derived"}, {"source", "test-base"}}
+	if !reflect.DeepEqual(ti.Origins, want) {
+		t.Fatalf("Origins = %v, want %v", ti.Origins, want)
+	}
+	for name, want := range map[string]string{
+		"bel":   "source test-top",
+		"Tc":    "source test-derived",
+		"clear": "source test-base",
+	} {
+		if o, ok := ti.Explain(name); !ok || o.String() != want {
+			t.Errorf("Explain(%q) = %v, want %v", name, o, want)
+		}
+	}
+
+	outer, err := Load("vt100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner, err := Load("rxvt-unicode-256color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti = Compose("vt100.rxvt-unicode-256color", outer, inner)
+	for name, want := range map[string]*Terminfo{"cup": outer, "kcuu1": inner, "colors": inner} {
+		if o, _ := ti.Explain(name); o != want.Origins[0] {
+			t.Errorf("Explain(%q) = %v, want %v", name, o, want.Origins[0])
+		}
+	}
+	if len(outer.CapOrigins) != 0 {
+		t.Error("Compose modified the outer entry")
+	}
+}
@@ -0,0 +1,8 @@
+//go:build race
+// +build race
+
+package terminfo
+
+// raceEnabled is whether the tests are run with the race detector, which
+// makes allocation counts unreliable.
+const raceEnabled = true
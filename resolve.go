@@ -0,0 +1,108 @@
+package terminfo
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ErrHashedDB is returned by Load when the terminfo database is a hashed
+// database that cannot be read, such as the terminfo.cdb of NetBSD or a
+// terminfo.db that is not a Berkeley DB hash database of version 4 or
+// later. Such databases can be converted with "infocmp -x | tic -o dir -"
+// into a directory tree.
+var ErrHashedDB = errors.New("terminfo: hashed terminfo databases are not supported")
+
+// hashedDBSuffixes are the suffixes of hashed databases next to or in place
+// of a directory tree: .cdb on NetBSD and .db for ncurses.
+var hashedDBSuffixes = []string{".cdb", ".db"}
+
+// entryResolver returns the path of the file under dir that holds the entry
+// with the name in a directory tree layout.
+type entryResolver func(dir, name string) string
+
+// letterDir is the layout of terminfo(5), where entries are in directories
+// named after their first character, as in x/xterm.
+func letterDir(dir, name string) string {
+	return path.Join(dir, name[0:1], name)
+}
+
+// hexDir is the layout used where file names are case-insensitive, such as
+// on macOS, where entries are in directories named after the hexadecimal
+// code of their first character, as in 78/xterm.
+func hexDir(dir, name string) string {
+	return path.Join(dir, strconv.FormatUint(uint64(name[0]), 16), name)
+}
+
+// openDir reads the Terminfo file specified by the dir and name, trying
+// the layouts of entryResolvers in order. dir may also be a compiled entry
+// file, which is used if it holds name, or a hashed database, which is
+// also looked for next to a missing dir.
+func (l *Loader) openDir(dir, name string) (*Terminfo, error) {
+	fi, err := l.stat(dir)
+	if err == nil && !fi.IsDir() {
+		if hashedDB(dir) {
+			return l.openHashed(dir, name)
+		}
+		return l.openFile(dir, name)
+	}
+	if err != nil {
+		for _, suffix := range hashedDBSuffixes {
+			if _, serr := l.stat(dir + suffix); serr == nil {
+				return l.openHashed(dir+suffix, name)
+			}
+		}
+		return nil, err
+	}
+	err = fs.ErrNotExist
+	for _, resolve := range entryResolvers {
+		file := resolve(dir, name)
+		b, rerr := l.readFile(file)
+		if rerr != nil {
+			continue
+		}
+		ti, derr := l.decode(b, file)
+		if derr != nil {
+			return nil, derr
+		}
+		if !collides(ti, name) {
+			return ti, nil
+		}
+	}
+	return nil, err
+}
+
+// openHashed reads the entry with the name from the hashed database file.
+// Only the terminfo.db of ncurses can be read.
+func (l *Loader) openHashed(file, name string) (*Terminfo, error) {
+	if !strings.HasSuffix(file, ".db") {
+		return nil, ErrHashedDB
+	}
+	return l.openHashDB(file, name)
+}
+
+// hashedDB reports whether the file is named like a hashed database.
+func hashedDB(file string) bool {
+	for _, suffix := range hashedDBSuffixes {
+		if strings.HasSuffix(file, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// collides reports whether ti was found for the name only because file
+// names are case-insensitive: one of its names differs from name only in
+// case and none equals it, as for eterm found in the place of Eterm.
+func collides(ti *Terminfo, name string) bool {
+	folded := false
+	for _, n := range ti.Names {
+		if n == name {
+			return false
+		}
+		folded = folded || strings.EqualFold(n, name)
+	}
+	return folded
+}
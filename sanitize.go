@@ -0,0 +1,57 @@
+package terminfo
+
+import (
+	"strings"
+
+	"github.com/nhooyr/terminfo/extcaps"
+)
+
+// knownExtCap reports whether name is a documented extended capability.
+// This includes xterm's modified keys such as kUP5 and the obsolete termcap
+// capabilities kept by tic with an OT prefix.
+func knownExtCap(name string) bool {
+	if _, ok := extcaps.Lookup(name); ok || strings.HasPrefix(name, "OT") {
+		return true
+	}
+	if _, ok := extKeys[name]; ok {
+		return true
+	}
+	n := len(name) - 1
+	if n > 0 && name[n] >= '2' && name[n] <= '8' {
+		_, ok := extKeys[name[:n]]
+		return ok
+	}
+	return false
+}
+
+// Sanitize returns a copy of ti suitable for attaching to bug reports.
+// It keeps the standard capabilities and the documented extended ones that
+// affect behavior, but drops other extended capabilities, which may hold
+// custom configuration, along with Notes, CapNotes and Layout.
+func Sanitize(ti *Terminfo) *Terminfo {
+	nti := &Terminfo{
+		Names:      append([]string(nil), ti.Names...),
+		Bools:      ti.Bools,
+		Numbers:    ti.Numbers,
+		Strings:    ti.Strings,
+		ExtBools:   make(map[string]bool),
+		ExtNumbers: make(map[string]int16),
+		ExtStrings: make(map[string]string),
+	}
+	for k, v := range ti.ExtBools {
+		if knownExtCap(k) {
+			nti.ExtBools[k] = v
+		}
+	}
+	for k, v := range ti.ExtNumbers {
+		if knownExtCap(k) {
+			nti.ExtNumbers[k] = v
+		}
+	}
+	for k, v := range ti.ExtStrings {
+		if knownExtCap(k) {
+			nti.ExtStrings[k] = v
+		}
+	}
+	return nti
+}
@@ -0,0 +1,31 @@
+package terminfo
+
+import "testing"
+
+func TestSanitize(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nti := *ti
+	nti.ExtStrings = map[string]string{
+		"kUP5":     "\x1b[1;5A",
+		"Ms":       "\x1b]52;%p1%s;%p2%s\a",
+		"myPrompt": "alice@example",
+	}
+	nti.ExtBools = map[string]bool{"AX": true, "secret": true}
+	nti.Notes = []string{"configured for alice"}
+	s := Sanitize(&nti)
+	if s.Strings != ti.Strings {
+		t.Error("standard strings changed")
+	}
+	if _, ok := s.ExtStrings["myPrompt"]; ok || s.ExtBools["secret"] {
+		t.Error("custom extended capabilities kept")
+	}
+	if s.ExtStrings["kUP5"] == "" || s.ExtStrings["Ms"] == "" || !s.ExtBools["AX"] {
+		t.Error("known extended capabilities dropped")
+	}
+	if s.Notes != nil {
+		t.Error("notes kept")
+	}
+}
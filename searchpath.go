@@ -0,0 +1,57 @@
+package terminfo
+
+import (
+	"os"
+	"strings"
+)
+
+// SearchPath returns the directories searched by Load for entries, in
+// order, following ncurses as described in terminfo(5):
+//
+//   - $TERMINFO, which may also be a compiled entry file
+//   - ~/.terminfo
+//   - each directory of $TERMINFO_DIRS, separated by ':', where an empty
+//     element stands for the system directory
+//   - the system directories of the operating system, such as
+//     /etc/terminfo, /lib/terminfo and /usr/share/terminfo on Linux
+//
+// Each directory is listed once, at its first position. Directories that
+// do not exist are listed too; Load skips them. Every directory is searched
+// until one holds the entry.
+func SearchPath() []string {
+	var dirs []string
+	seen := make(map[string]bool)
+	add := func(dir string) {
+		if !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+	if terminfo := os.Getenv("TERMINFO"); terminfo != "" {
+		add(terminfo)
+	}
+	if home := os.Getenv("HOME"); home != "" {
+		add(home + "/.terminfo")
+	}
+	if tdirs := os.Getenv("TERMINFO_DIRS"); tdirs != "" {
+		for _, dir := range strings.Split(tdirs, ":") {
+			if dir == "" {
+				dir = systemDir
+			}
+			add(dir)
+		}
+	}
+	for _, dir := range systemDirs {
+		add(dir)
+	}
+	return dirs
+}
+
+// SearchPath returns the directories searched by the Loader, see the
+// function SearchPath for the Loader used by Load.
+func (l *Loader) SearchPath() []string {
+	if l.dirs == nil {
+		return SearchPath()
+	}
+	return append([]string(nil), l.dirs...)
+}
@@ -0,0 +1,15 @@
+//go:build freebsd || openbsd || netbsd || dragonfly
+// +build freebsd openbsd netbsd dragonfly
+
+package terminfo
+
+// systemDir is the directory of the system's terminfo database, which an
+// empty element of $TERMINFO_DIRS stands for.
+const systemDir = "/usr/share/terminfo"
+
+// systemDirs are the directories searched after those of the environment.
+// The ncurses port installs its entries under /usr/local.
+var systemDirs = []string{"/usr/share/terminfo", "/usr/local/share/terminfo"}
+
+// entryResolvers are the layouts of directory trees tried by Load in order.
+var entryResolvers = []entryResolver{letterDir, hexDir}
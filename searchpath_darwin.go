@@ -0,0 +1,20 @@
+package terminfo
+
+// systemDir is the directory of the system's terminfo database, which an
+// empty element of $TERMINFO_DIRS stands for.
+const systemDir = "/usr/share/terminfo"
+
+// systemDirs are the directories searched after those of the environment.
+// The entries of macOS are often dated, so those of a newer ncurses
+// installed by Homebrew or MacPorts come first.
+var systemDirs = []string{
+	"/opt/homebrew/share/terminfo",
+	"/usr/local/share/terminfo",
+	"/opt/local/share/terminfo",
+	"/usr/share/terminfo",
+}
+
+// entryResolvers are the layouts of directory trees tried by Load in order.
+// macOS ships hexadecimal directories, as its file system is usually
+// case-insensitive, where letter directories would make A/ and a/ collide.
+var entryResolvers = []entryResolver{hexDir, letterDir}
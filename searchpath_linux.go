@@ -0,0 +1,15 @@
+package terminfo
+
+// systemDir is the directory of the system's terminfo database, which an
+// empty element of $TERMINFO_DIRS stands for.
+const systemDir = "/usr/share/terminfo"
+
+// systemDirs are the directories searched after those of the environment.
+// Debian and its derivatives keep essential entries in /lib/terminfo and
+// local ones in /etc/terminfo.
+var systemDirs = []string{"/etc/terminfo", "/lib/terminfo", "/usr/share/terminfo"}
+
+// entryResolvers are the layouts of directory trees tried by Load in order.
+// Linux file systems are case-sensitive and use terminfo(5)'s letter
+// directories, but databases copied from macOS use hexadecimal ones.
+var entryResolvers = []entryResolver{letterDir, hexDir}
@@ -0,0 +1,16 @@
+//go:build !linux && !darwin && !freebsd && !openbsd && !netbsd && !dragonfly
+// +build !linux,!darwin,!freebsd,!openbsd,!netbsd,!dragonfly
+
+package terminfo
+
+// systemDir is the directory of the system's terminfo database, which an
+// empty element of $TERMINFO_DIRS stands for.
+const systemDir = "/usr/share/terminfo"
+
+// systemDirs are the directories searched after those of the environment.
+var systemDirs = []string{"/etc/terminfo", "/lib/terminfo", "/usr/share/terminfo"}
+
+// entryResolvers are the layouts of directory trees tried by Load in order.
+// The file systems of other systems, such as Windows, are often
+// case-insensitive, so hexadecimal directories are tried first.
+var entryResolvers = []entryResolver{hexDir, letterDir}
@@ -0,0 +1,100 @@
+package terminfo
+
+import (
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// DecodeHeaderOnly returns the names of the compiled terminfo entry in b
+// without decoding its capabilities. Only the header and the names are
+// checked, so the rest of the entry may still be corrupt.
+func DecodeHeaderOnly(b []byte) (names []string, err error) {
+	d := &decoder{buf: b}
+	h, err := d.start()
+	if err != nil {
+		return nil, err
+	}
+	return d.names(h[lenNames])
+}
+
+// SectionReader reads the capabilities of a compiled terminfo entry as they
+// are accessed, without decoding the whole entry. It references the buffer
+// it was created with, which must not be modified while it is used.
+type SectionReader struct {
+	b     []byte
+	names []string
+	bools []byte
+	nums  []byte
+	offs  []byte
+	table []byte
+	d     *decoder
+}
+
+// NewSectionReader returns a SectionReader for the compiled entry in b.
+// The sizes of the sections of the standard capabilities are checked, but
+// strings are only checked when they are read. The extended capabilities
+// are only read by Decode.
+func NewSectionReader(b []byte) (*SectionReader, error) {
+	d := &decoder{buf: b}
+	h, err := d.start()
+	if err != nil {
+		return nil, err
+	}
+	r := &SectionReader{b: b, d: d}
+	if r.names, err = d.names(h[lenNames]); err != nil {
+		return nil, err
+	}
+	if r.bools, err = d.next(h[lenBools]); err != nil {
+		return nil, err
+	}
+	d.evenBoundary()
+	if r.nums, err = d.next(h[lenNumbers] * 2); err != nil {
+		return nil, err
+	}
+	if r.offs, err = d.next(h[lenStrings] * 2); err != nil {
+		return nil, err
+	}
+	if r.table, err = d.next(h[lenTable]); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Names returns the names of the entry.
+func (r *SectionReader) Names() []string {
+	return r.names
+}
+
+// Bool returns the boolean capability at i, such as caps.AutoRightMargin.
+func (r *SectionReader) Bool(i int) bool {
+	return i >= 0 && i < len(r.bools) && r.bools[i] == 1
+}
+
+// Number returns the number capability at i, such as caps.Columns. ok is
+// false if the entry lacks it or cancels it.
+func (r *SectionReader) Number(i int) (n int, ok bool) {
+	if i < 0 || i >= len(r.nums)/2 {
+		return 0, false
+	}
+	n = r.d.short(i*2, r.nums)
+	return n, n >= 0
+}
+
+// String returns the string capability at i, such as caps.CursorAddress,
+// decoding it from the string table. ok is false if the entry lacks it or
+// cancels it, or if the string is corrupt.
+func (r *SectionReader) String(i int) (s string, ok bool) {
+	if i < 0 || i >= len(r.offs)/2 || i >= caps.StringCount {
+		return "", false
+	}
+	off := r.d.short(i*2, r.offs)
+	if off < 0 {
+		return "", false
+	}
+	s, err := r.d.cString(0, r.table, off)
+	return s, err == nil
+}
+
+// Decode decodes the whole entry, like DecodeBytes.
+func (r *SectionReader) Decode() (*Terminfo, error) {
+	return DecodeBytes(r.b)
+}
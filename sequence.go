@@ -0,0 +1,101 @@
+package terminfo
+
+import (
+	"strconv"
+	"strings"
+)
+
+// These are the ECMA-48 introducers and terminator of control sequences
+// and control strings, in their 7-bit forms.
+const (
+	csi = "\x1b["
+	osc = "\x1b]"
+	dcs = "\x1bP"
+	st  = "\x1b\\"
+)
+
+// CSI returns the control sequence with the numeric parameters and the
+// final byte, optionally preceded by intermediate bytes, for example
+// CSI([]int{1, 5}, "H") moves the cursor and CSI([]int{2}, " q") sets the
+// cursor style. Negative parameters are left empty so the terminal uses
+// their defaults. CSI panics if final is not made of intermediate bytes
+// (0x20 to 0x2F) followed by a final byte (0x40 to 0x7E).
+func CSI(params []int, final string) string {
+	return PrivateCSI(0, params, final)
+}
+
+// PrivateCSI is like CSI but starts the parameters with the private
+// marker, one of '<', '=', '>' and '?', as in PrivateCSI('?', []int{2004}, "h").
+// A zero marker is left out.
+func PrivateCSI(marker byte, params []int, final string) string {
+	checkFinal(final)
+	var b strings.Builder
+	b.WriteString(csi)
+	if marker != 0 {
+		if marker < '<' || marker > '?' {
+			panic("terminfo: bad private marker " + strconv.QuoteRune(rune(marker)))
+		}
+		b.WriteByte(marker)
+	}
+	writeParams(&b, params)
+	b.WriteString(final)
+	return b.String()
+}
+
+// OSC returns the operating system command with the number and payload,
+// terminated by ST, for example OSC(2, "title") sets the window title.
+// Control characters are removed from the payload so it cannot end the
+// command early.
+func OSC(num int, payload string) string {
+	return osc + strconv.Itoa(num) + ";" + stripControls(payload) + st
+}
+
+// DCS returns the device control string with the numeric parameters, the
+// final byte optionally preceded by intermediate bytes, and the data,
+// terminated by ST. For example DCS(nil, "+q", "636f6c6f7273") queries the
+// colors capability with XTGETTCAP. Control characters are removed from the
+// data. DCS panics if final is malformed, as described by CSI.
+func DCS(params []int, final, data string) string {
+	checkFinal(final)
+	var b strings.Builder
+	b.WriteString(dcs)
+	writeParams(&b, params)
+	b.WriteString(final)
+	b.WriteString(stripControls(data))
+	b.WriteString(st)
+	return b.String()
+}
+
+// writeParams writes the parameters separated by semicolons.
+func writeParams(b *strings.Builder, params []int) {
+	for i, p := range params {
+		if i > 0 {
+			b.WriteByte(';')
+		}
+		if p >= 0 {
+			b.WriteString(strconv.Itoa(p))
+		}
+	}
+}
+
+// checkFinal panics if final is not intermediate bytes followed by a final byte.
+func checkFinal(final string) {
+	n := len(final) - 1
+	ok := n >= 0 && final[n] >= 0x40 && final[n] <= 0x7e
+	for i := 0; ok && i < n; i++ {
+		ok = final[i] >= 0x20 && final[i] <= 0x2f
+	}
+	if !ok {
+		panic("terminfo: bad final bytes " + strconv.Quote(final))
+	}
+}
+
+// stripControls removes the C0 and C1 control characters from s.
+func stripControls(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < ' ' || r >= 0x7f && r < 0xa0 {
+			return -1
+		}
+		return r
+	}, s)
+}
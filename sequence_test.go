@@ -0,0 +1,28 @@
+package terminfo
+
+import "testing"
+
+func TestSequences(t *testing.T) {
+	tests := []struct {
+		got, want string
+	}{
+		{CSI(nil, "H"), "\x1b[H"},
+		{CSI([]int{5, 10}, "H"), "\x1b[5;10H"},
+		{CSI([]int{-1, 10}, "H"), "\x1b[;10H"},
+		{CSI([]int{2}, " q"), "\x1b[2 q"},
+		{PrivateCSI('?', []int{2004}, "h"), "\x1b[?2004h"},
+		{OSC(2, "title\x07\x1b]evil"), "\x1b]2;title]evil\x1b\\"},
+		{DCS(nil, "+q", "636f6c6f7273"), "\x1bP+q636f6c6f7273\x1b\\"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("CSI did not panic for a bad final byte")
+		}
+	}()
+	CSI(nil, "\x1b")
+}
@@ -0,0 +1,104 @@
+package terminfo
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// ErrUnknownSize is returned by Size when the size of the terminal could not
+// be found by any means.
+var ErrUnknownSize = errors.New("terminfo: unknown terminal size")
+
+// Size returns the number of rows and columns of the terminal f. The size
+// is asked from the terminal (TIOCGWINSZ on Unix, the console on Windows)
+// if f is not nil, falling back to $LINES and $COLUMNS and finally to the
+// lines and cols capabilities, separately for each dimension.
+// ErrUnknownSize is returned if a dimension is still unknown.
+func (ti *Terminfo) Size(f *os.File) (rows, cols int, err error) {
+	if f != nil {
+		rows, cols, _ = termSize(f)
+	}
+	if rows <= 0 {
+		rows = envSize("LINES", ti.Numbers[caps.Lines])
+	}
+	if cols <= 0 {
+		cols = envSize("COLUMNS", ti.Numbers[caps.Columns])
+	}
+	if rows <= 0 || cols <= 0 {
+		return rows, cols, ErrUnknownSize
+	}
+	return rows, cols, nil
+}
+
+// envSize returns the positive number in the environment variable, or def.
+func envSize(key string, def int16) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil && n > 0 {
+		return n
+	}
+	return int(def)
+}
+
+// UpdateSize sets the size of t to the size of the terminal f, see
+// Terminfo.Size. It is left unchanged if the size is unknown.
+func (t *Term) UpdateSize(f *os.File) error {
+	rows, cols, err := t.Size(f)
+	if err != nil {
+		return err
+	}
+	t.SetSize(rows, cols)
+	return nil
+}
+
+// SetSize sets the size of t, which Setup takes from the lines and cols
+// capabilities.
+func (t *Term) SetSize(lines, cols int) {
+	t.sizeMu.Lock()
+	t.lines, t.cols = lines, cols
+	t.sizeMu.Unlock()
+}
+
+// WinSize returns the size of t. It is safe while WatchSize is running.
+func (t *Term) WinSize() (lines, cols int) {
+	t.sizeMu.Lock()
+	defer t.sizeMu.Unlock()
+	return t.lines, t.cols
+}
+
+// WatchSize calls UpdateSize for the terminal f now and whenever it is
+// resized, on SIGWINCH, and then calls resized if it is not nil with the
+// new size. On systems without SIGWINCH, the size is only updated once. The returned function stops
+// watching.
+func (t *Term) WatchSize(f *os.File, resized func(lines, cols int)) (stop func()) {
+	update := func() {
+		if t.UpdateSize(f) == nil && resized != nil {
+			resized(t.WinSize())
+		}
+	}
+	update()
+	sig := make(chan os.Signal, 1)
+	if !notifyResize(sig) {
+		return func() {}
+	}
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-sig:
+				update()
+			case <-done:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			stopResize(sig)
+			close(done)
+		})
+	}
+}
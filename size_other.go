@@ -0,0 +1,19 @@
+//go:build !linux && !darwin && !freebsd && !openbsd && !netbsd && !dragonfly && !windows
+// +build !linux,!darwin,!freebsd,!openbsd,!netbsd,!dragonfly,!windows
+
+package terminfo
+
+import "os"
+
+// termSize reports that the size of terminals is unknown.
+func termSize(f *os.File) (rows, cols int, err error) {
+	return 0, 0, ErrUnknownSize
+}
+
+// notifyResize reports false, as resizes are not signalled.
+func notifyResize(ch chan<- os.Signal) bool {
+	return false
+}
+
+// stopResize does nothing.
+func stopResize(ch chan<- os.Signal) {}
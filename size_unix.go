@@ -0,0 +1,38 @@
+//go:build linux || darwin || freebsd || openbsd || netbsd || dragonfly
+// +build linux darwin freebsd openbsd netbsd dragonfly
+
+package terminfo
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"unsafe"
+)
+
+// winsize is the struct winsize of TIOCGWINSZ.
+type winsize struct {
+	rows, cols, xpixel, ypixel uint16
+}
+
+// termSize asks the terminal f for its size.
+func termSize(f *os.File) (rows, cols int, err error) {
+	var ws winsize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), syscall.TIOCGWINSZ, uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 {
+		return 0, 0, errno
+	}
+	return int(ws.rows), int(ws.cols), nil
+}
+
+// notifyResize makes the terminal size changes be sent on ch, reporting
+// whether the system supports it.
+func notifyResize(ch chan<- os.Signal) bool {
+	signal.Notify(ch, syscall.SIGWINCH)
+	return true
+}
+
+// stopResize stops notifyResize.
+func stopResize(ch chan<- os.Signal) {
+	signal.Stop(ch)
+}
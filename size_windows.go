@@ -0,0 +1,38 @@
+//go:build windows
+// +build windows
+
+package terminfo
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var procGetConsoleScreenBufferInfo = syscall.NewLazyDLL("kernel32.dll").NewProc("GetConsoleScreenBufferInfo")
+
+// consoleScreenBufferInfo is the CONSOLE_SCREEN_BUFFER_INFO of the console API.
+type consoleScreenBufferInfo struct {
+	size, cursorPosition     [2]int16
+	attributes               uint16
+	left, top, right, bottom int16
+	maximumWindowSize        [2]int16
+}
+
+// termSize asks the console f for the size of its window.
+func termSize(f *os.File) (rows, cols int, err error) {
+	var info consoleScreenBufferInfo
+	r, _, err := procGetConsoleScreenBufferInfo.Call(f.Fd(), uintptr(unsafe.Pointer(&info)))
+	if r == 0 {
+		return 0, 0, err
+	}
+	return int(info.bottom-info.top) + 1, int(info.right-info.left) + 1, nil
+}
+
+// notifyResize reports false, as the console does not signal resizes.
+func notifyResize(ch chan<- os.Signal) bool {
+	return false
+}
+
+// stopResize does nothing.
+func stopResize(ch chan<- os.Signal) {}
@@ -0,0 +1,454 @@
+package terminfo
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// These are the source parsing errors.
+var (
+	ErrBadSource  = errors.New("terminfo: bad source entry")
+	ErrUseLoop    = errors.New("terminfo: use= loop")
+	ErrBadCapName = errors.New("terminfo: bad capability name")
+)
+
+// WriteSource writes ti to w in the terminfo source format read by tic(1),
+// with one capability per line like infocmp -1 -x. In each group of
+// booleans, numbers and strings, the standard capabilities sorted by name
+// are followed by the extended ones sorted by name. Notes and CapNotes are written as comments preceding
+// the entry and the capabilities they describe.
+func (ti *Terminfo) WriteSource(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	writeNotes(bw, "", ti.Notes)
+	bw.WriteString(strings.Join(ti.Names, "|"))
+	bw.WriteString(",\n")
+	for _, c := range ti.sourceCaps() {
+		writeNotes(bw, "\t", ti.CapNotes[c.name])
+		bw.WriteString("\t")
+		bw.WriteString(c.String())
+		bw.WriteString(",\n")
+	}
+	return bw.Flush()
+}
+
+// writeNotes writes notes as comments, each line prefixed by indent.
+func writeNotes(w *bufio.Writer, indent string, notes []string) {
+	for _, n := range notes {
+		for _, line := range strings.Split(n, "\n") {
+			w.WriteString(indent)
+			w.WriteString("#")
+			if line != "" {
+				w.WriteString(" ")
+				w.WriteString(line)
+			}
+			w.WriteString("\n")
+		}
+	}
+}
+
+// sourceCap is a capability formatted for the source format.
+type sourceCap struct {
+	name  string
+	sep   string // "" for booleans, "#" for numbers and "=" for strings
+	value string
+}
+
+// String returns the capability as written in the source format.
+func (c sourceCap) String() string {
+	return c.name + c.sep + c.value
+}
+
+// sourceCaps returns the capabilities present in ti, formatted for the
+// source format and sorted as described by WriteSource.
+func (ti *Terminfo) sourceCaps() []sourceCap {
+	var bools, nums, strs []sourceCap
+	sortCaps := func(cs []sourceCap) {
+		sort.Slice(cs, func(i, j int) bool {
+			return cs[i].name < cs[j].name
+		})
+	}
+	for i, v := range ti.Bools {
+		if v {
+			bools = append(bools, sourceCap{caps.BoolNames[i], "", ""})
+		}
+	}
+	sortCaps(bools)
+	for _, k := range sortedKeys(ti.ExtBools) {
+		if ti.ExtBools[k] {
+			bools = append(bools, sourceCap{k, "", ""})
+		}
+	}
+	for i, v := range ti.Numbers {
+		switch n := caps.NumberNames[i]; {
+		case v != 0 || ti.CapStates[n] == CapEmpty:
+			nums = append(nums, sourceCap{n, "#", sourceNumber(v)})
+		case ti.CapStates[n] == CapCancelled:
+			nums = append(nums, sourceCap{n, "@", ""})
+		}
+	}
+	sortCaps(nums)
+	for _, k := range sortedKeys(ti.ExtNumbers) {
+		nums = append(nums, sourceCap{k, "#", sourceNumber(ti.ExtNumbers[k])})
+	}
+	for i, v := range ti.Strings {
+		switch n := caps.StringNames[i]; {
+		case v != "" || ti.CapStates[n] == CapEmpty:
+			strs = append(strs, sourceCap{n, "=", EscapeSource(v)})
+		case ti.CapStates[n] == CapCancelled:
+			strs = append(strs, sourceCap{n, "@", ""})
+		}
+	}
+	sortCaps(strs)
+	for _, k := range sortedKeys(ti.ExtStrings) {
+		strs = append(strs, sourceCap{k, "=", EscapeSource(ti.ExtStrings[k])})
+	}
+	return append(append(bools, nums...), strs...)
+}
+
+// sourceNumber formats n like infocmp, in hexadecimal past 255 when within
+// 16 of a power of two, such as colors#0x100 and pairs#0x7fff.
+func sourceNumber(n int16) string {
+	if n > 255 {
+		for p := 256; p <= 1<<16; p <<= 1 {
+			if v := int(n); v >= p-16 && v < p+16 {
+				return "0x" + strconv.FormatInt(int64(n), 16)
+			}
+		}
+	}
+	return strconv.Itoa(int(n))
+}
+
+// EscapeSource escapes s for use as a string value in the source format,
+// the way infocmp does. Control characters are written in the ^X form in
+// strings of up to 3 bytes and before digits, and in octal otherwise.
+func EscapeSource(s string) string {
+	long := len(s) > 3
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\x1b':
+			b.WriteString(`\E`)
+		case c == '\n':
+			b.WriteString(`\n`)
+		case c == '\r':
+			b.WriteString(`\r`)
+		case c == ',':
+			b.WriteString(`\,`)
+		case c == '\\':
+			b.WriteString(`\\`)
+		case c == '^':
+			b.WriteString(`\^`)
+		case c == ' ' && (i == 0 || i == len(s)-1):
+			// Spaces elsewhere are kept as is.
+			b.WriteString(`\s`)
+		case c == 0:
+			b.WriteString(`\200`)
+		case c == 0x7f:
+			b.WriteString("^?")
+		case c < ' ' && (!long || i+1 < len(s) && s[i+1] >= '0' && s[i+1] <= '9'):
+			b.WriteByte('^')
+			b.WriteByte(c + '@')
+		case c < ' ' || c > 0x7f:
+			b.WriteString(`\` + strconv.FormatInt(int64(c)|01000, 8)[1:])
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// UnescapeSource decodes the escapes of a string value in the source format.
+func UnescapeSource(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '^' && i+1 < len(s):
+			i++
+			if s[i] == '?' {
+				b.WriteByte(0x7f)
+			} else {
+				b.WriteByte(s[i] & 0x1f)
+			}
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch c = s[i]; c {
+			case 'E', 'e':
+				b.WriteByte('\x1b')
+			case 'n', 'l':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 's':
+				b.WriteByte(' ')
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				n := 0
+				for j := 0; j < 3 && i < len(s) && s[i] >= '0' && s[i] <= '7'; j++ {
+					n = n*8 + int(s[i]-'0')
+					i++
+				}
+				i--
+				if n == 0 || n == 0200 {
+					// \0 and \200 both represent a null byte.
+					n = 0
+				}
+				b.WriteByte(byte(n))
+			default:
+				b.WriteByte(c)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// sourceEntry is an entry being parsed, before use= resolution.
+type sourceEntry struct {
+	ti       *Terminfo
+	uses     []string
+	seen     map[string]bool // capabilities set or cancelled in the entry
+	resolved bool
+	visiting bool
+}
+
+// ParseSource parses the entries in r, which is in the terminfo source
+// format read by tic(1). use= references are resolved against the other
+// entries in r first and then with Load. Capabilities that are not standard
+// are stored as extended capabilities. Comments preceding an entry are kept
+// in Notes and comments preceding a capability in CapNotes.
+func ParseSource(r io.Reader) ([]*Terminfo, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		entries []*sourceEntry
+		cur     *sourceEntry
+		notes   []string
+	)
+	for _, line := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			continue
+		case trimmed[0] == '#':
+			notes = append(notes, strings.TrimPrefix(trimmed[1:], " "))
+			continue
+		case line[0] != ' ' && line[0] != '\t':
+			// A new entry.
+			cur = &sourceEntry{
+				ti: &Terminfo{
+					Notes:      notes,
+					ExtBools:   make(map[string]bool),
+					ExtNumbers: make(map[string]int16),
+					ExtStrings: make(map[string]string),
+				},
+				seen: make(map[string]bool),
+			}
+			notes = nil
+			entries = append(entries, cur)
+		case cur == nil:
+			return nil, ErrBadSource
+		}
+		for _, f := range splitSource(trimmed) {
+			if cur.ti.Names == nil {
+				cur.ti.Names = strings.Split(f, "|")
+				continue
+			}
+			name, err := cur.parseField(f)
+			if err != nil {
+				return nil, err
+			}
+			if len(notes) > 0 && name != "" {
+				if cur.ti.CapNotes == nil {
+					cur.ti.CapNotes = make(map[string][]string)
+				}
+				cur.ti.CapNotes[name] = notes
+			}
+			notes = nil
+		}
+	}
+	byName := make(map[string]*sourceEntry)
+	for _, e := range entries {
+		if e.ti.Names == nil {
+			return nil, ErrBadSource
+		}
+		e.ti.Origins = []Origin{{Kind: "source", Name: e.ti.Names[0]}}
+		for _, n := range e.ti.Names {
+			byName[n] = e
+		}
+	}
+	tis := make([]*Terminfo, len(entries))
+	for i, e := range entries {
+		if err := e.resolve(byName); err != nil {
+			return nil, err
+		}
+		tis[i] = e.ti
+	}
+	return tis, nil
+}
+
+// splitSource splits a line into its comma separated fields.
+func splitSource(line string) []string {
+	var fields []string
+	start := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case ',':
+			if f := strings.TrimSpace(line[start:i]); f != "" {
+				fields = append(fields, f)
+			}
+			start = i + 1
+		}
+	}
+	if f := strings.TrimSpace(line[start:]); f != "" {
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// parseField parses a capability field and returns its name.
+func (e *sourceEntry) parseField(f string) (name string, err error) {
+	end := strings.IndexAny(f, "=#@")
+	if end == -1 {
+		end = len(f)
+	}
+	name = f[:end]
+	if name == "" {
+		return "", ErrBadCapName
+	}
+	if name == "use" && end < len(f) && f[end] == '=' {
+		e.uses = append(e.uses, f[end+1:])
+		return "", nil
+	}
+	e.seen[name] = true
+	ti := e.ti
+	switch {
+	case end == len(f):
+		if i, ok := caps.LookupBool(name); ok {
+			ti.Bools[i] = true
+		} else {
+			ti.ExtBools[name] = true
+		}
+	case f[end] == '@':
+		if i, ok := caps.LookupNumber(name); ok {
+			ti.setCapState(caps.NumberNames[i], CapCancelled)
+		} else if i, ok := caps.LookupString(name); ok {
+			ti.setCapState(caps.StringNames[i], CapCancelled)
+		}
+	case f[end] == '#':
+		n, err := strconv.ParseInt(f[end+1:], 0, 32)
+		if err != nil {
+			return "", ErrBadSource
+		}
+		if n > 32767 {
+			n = 32767
+		}
+		if i, ok := caps.LookupNumber(name); ok {
+			ti.Numbers[i] = int16(n)
+			ti.setCapState(caps.NumberNames[i], zeroState(n == 0))
+		} else {
+			ti.ExtNumbers[name] = int16(n)
+		}
+	case f[end] == '=':
+		v := UnescapeSource(f[end+1:])
+		if i, ok := caps.LookupString(name); ok {
+			ti.Strings[i] = v
+			ti.setCapState(caps.StringNames[i], zeroState(v == ""))
+		} else {
+			ti.ExtStrings[name] = v
+		}
+	}
+	return name, nil
+}
+
+// resolve fills in the capabilities inherited through use=.
+func (e *sourceEntry) resolve(byName map[string]*sourceEntry) error {
+	if e.resolved {
+		return nil
+	}
+	if e.visiting {
+		return ErrUseLoop
+	}
+	e.visiting = true
+	defer func() { e.visiting = false }()
+	for _, name := range e.uses {
+		var use *Terminfo
+		if ue, ok := byName[name]; ok {
+			if err := ue.resolve(byName); err != nil {
+				return err
+			}
+			use = ue.ti
+		} else {
+			var err error
+			if use, err = Load(name); err != nil {
+				return err
+			}
+		}
+		e.inherit(use)
+	}
+	e.resolved = true
+	return nil
+}
+
+// inherit copies the capabilities of use that were neither set nor
+// cancelled in the entry.
+func (e *sourceEntry) inherit(use *Terminfo) {
+	ti := e.ti
+	off := ti.addOrigins(use)
+	for i, v := range use.Bools {
+		if n := caps.BoolNames[i]; v && !e.seen[n] {
+			ti.Bools[i], e.seen[n] = true, true
+			ti.setOrigin(n, use, off)
+		}
+	}
+	for i, v := range use.Numbers {
+		if n := caps.NumberNames[i]; use.Number(i) >= 0 && !e.seen[n] {
+			ti.Numbers[i], e.seen[n] = v, true
+			ti.setCapState(n, zeroState(v == 0))
+			ti.setOrigin(n, use, off)
+		}
+	}
+	for i, v := range use.Strings {
+		if _, ok := use.stringCap(i); ok && !e.seen[caps.StringNames[i]] {
+			n := caps.StringNames[i]
+			ti.Strings[i], e.seen[n] = v, true
+			ti.setCapState(n, zeroState(v == ""))
+			ti.setOrigin(n, use, off)
+		}
+	}
+	for k, v := range use.ExtBools {
+		if !e.seen[k] {
+			ti.ExtBools[k], e.seen[k] = v, true
+			ti.setOrigin(k, use, off)
+		}
+	}
+	for k, v := range use.ExtNumbers {
+		if !e.seen[k] {
+			ti.ExtNumbers[k], e.seen[k] = v, true
+			ti.setOrigin(k, use, off)
+		}
+	}
+	for k, v := range use.ExtStrings {
+		if !e.seen[k] {
+			ti.ExtStrings[k], e.seen[k] = v, true
+			ti.setOrigin(k, use, off)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package terminfo
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+const testSource = `# Local terminal definitions.
+# Maintained by the platform team.
+test-base|base entry,
+	am, cols#80,
+	clear=\E[H\E[J, cup=\E[%i%p1%d;%p2%dH,
+test-derived|derived entry,
+	# Our multiplexer breaks automargins.
+	am@,
+	# Force truecolor.
+	Tc, bel=^G, sp=a\sb\,c\^\\,
+	use=test-base,
+`
+
+func TestParseSource(t *testing.T) {
+	tis, err := ParseSource(strings.NewReader(testSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tis) != 2 {
+		t.Fatalf("got %d entries, want 2", len(tis))
+	}
+	base, ti := tis[0], tis[1]
+	if !reflect.DeepEqual(base.Notes, []string{"Local terminal definitions.", "Maintained by the platform team."}) {
+		t.Errorf("Notes = %q", base.Notes)
+	}
+	if ti.Bools[caps.AutoRightMargin] {
+		t.Error("am was not cancelled")
+	}
+	if ti.Numbers[caps.Columns] != 80 || ti.Strings[caps.ClearScreen] != "\x1b[H\x1b[J" {
+		t.Error("capabilities were not inherited through use=")
+	}
+	if !ti.ExtBools["Tc"] || ti.Strings[caps.Bell] != "\a" || ti.ExtStrings["sp"] != `a b,c^\` {
+		t.Errorf("unexpected capabilities: Tc=%v bel=%q sp=%q", ti.ExtBools["Tc"], ti.Strings[caps.Bell], ti.ExtStrings["sp"])
+	}
+	if got := ti.CapNotes["Tc"]; !reflect.DeepEqual(got, []string{"Force truecolor."}) {
+		t.Errorf("CapNotes[Tc] = %q", got)
+	}
+	if got := ti.CapNotes["am"]; !reflect.DeepEqual(got, []string{"Our multiplexer breaks automargins."}) {
+		t.Errorf("CapNotes[am] = %q", got)
+	}
+}
+
+func TestSourceRoundTrip(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nti := *ti
+	nti.Notes = []string{"A note."}
+	nti.CapNotes = map[string][]string{"cup": {"About cup."}}
+	b := new(bytes.Buffer)
+	if err = nti.WriteSource(b); err != nil {
+		t.Fatal(err)
+	}
+	tis, err := ParseSource(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nti.Origins = []Origin{{Kind: "source", Name: "xterm"}}
+	if !reflect.DeepEqual(tis[0], &nti) {
+		t.Errorf("round trip mismatch:\n%s", b)
+	}
+}
+
+func TestSourceNumber(t *testing.T) {
+	for n, want := range map[int16]string{
+		80:     "80",
+		256:    "0x100",
+		0x7fff: "0x7fff",
+		1000:   "1000",
+		0x100f: "0x100f",
+		0x1010: "4112",
+	} {
+		if got := sourceNumber(n); got != want {
+			t.Errorf("sourceNumber(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
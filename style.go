@@ -0,0 +1,102 @@
+package terminfo
+
+import (
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Attr is a set of video attributes for Style.
+type Attr uint
+
+// These are the attributes of terminfo(5). Italic is not a parameter of
+// sgr, so it is turned on with sitm.
+const (
+	Bold Attr = 1 << iota
+	Underline
+	Reverse
+	Blink
+	Dim
+	Italic
+	Invisible
+	Standout
+	Protected
+	AltCharset
+)
+
+// sgrAttrs are the attributes in the order of the parameters of sgr.
+var sgrAttrs = [...]Attr{Standout, Underline, Reverse, Blink, Dim, Bold, Invisible, Protected, AltCharset}
+
+// enterAttrs are the strings turning on each attribute on their own.
+var enterAttrs = []struct {
+	attr Attr
+	cap  int
+}{
+	{Standout, caps.EnterStandoutMode},
+	{Underline, caps.EnterUnderlineMode},
+	{Reverse, caps.EnterReverseMode},
+	{Blink, caps.EnterBlinkMode},
+	{Dim, caps.EnterDimMode},
+	{Bold, caps.EnterBoldMode},
+	{Invisible, caps.EnterSecureMode},
+	{Protected, caps.EnterProtectedMode},
+	{AltCharset, caps.EnterAltCharsetMode},
+	{Italic, caps.EnterItalicsMode},
+}
+
+// Style returns the string turning off all attributes and then turning on
+// attrs. It is built from set_attributes (sgr) if the terminal has it, which
+// also turns the alternate character set on or off, and otherwise from
+// exit_attribute_mode (sgr0) followed by the enter strings of the attributes,
+// such as enter_bold_mode. Attributes the terminal lacks are left out. The
+// result may hold padding, see Puts.
+func (ti *Terminfo) Style(attrs Attr) string {
+	if attrs == 0 {
+		return ti.StyleReset()
+	}
+	var b strings.Builder
+	if sgr := ti.Strings[caps.SetAttributes]; sgr != "" {
+		var p [len(sgrAttrs)]interface{}
+		for i, a := range sgrAttrs {
+			p[i] = attrs&a != 0
+		}
+		b.WriteString(Parm(sgr, p[:]...))
+		if attrs&Italic != 0 {
+			b.WriteString(ti.Strings[caps.EnterItalicsMode])
+		}
+		return b.String()
+	}
+	b.WriteString(ti.StyleReset())
+	for _, e := range enterAttrs {
+		if attrs&e.attr != 0 {
+			b.WriteString(ti.Strings[e.cap])
+		}
+	}
+	return b.String()
+}
+
+// StyleReset returns the string turning off all attributes: sgr0, sgr with
+// no attributes, or the exit strings of the attributes that have one.
+func (ti *Terminfo) StyleReset() string {
+	if sgr0 := ti.Strings[caps.ExitAttributeMode]; sgr0 != "" {
+		return sgr0
+	}
+	if sgr := ti.Strings[caps.SetAttributes]; sgr != "" {
+		return Parm(sgr, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+	}
+	return ti.Strings[caps.ExitStandoutMode] + ti.Strings[caps.ExitUnderlineMode] +
+		ti.Strings[caps.ExitItalicsMode] + ti.Strings[caps.ExitAltCharsetMode]
+}
+
+// SetAttrs turns off all attributes and then turns on attrs, see
+// Terminfo.Style, and records ModeAttributes for Restore.
+func (t *Term) SetAttrs(attrs Attr) error {
+	if attrs == 0 {
+		return t.ResetStyle()
+	}
+	if err := t.putTo(t.w, t.Style(attrs), false); err != nil {
+		return err
+	}
+	t.Modes.Set(ModeAttributes)
+	return nil
+}
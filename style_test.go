@@ -0,0 +1,53 @@
+package terminfo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestStyle(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		attrs Attr
+		want  string
+	}{
+		{0, "\x1b(B\x1b[m"},
+		{Bold | Underline | AltCharset, "\x1b(0\x1b[0;1;4m"},
+		{Reverse | Standout, "\x1b(B\x1b[0;7m"},
+		{Bold | Italic, "\x1b(B\x1b[0;1m\x1b[3m"},
+	}
+	for _, tt := range tests {
+		if got := ti.Style(tt.attrs); got != tt.want {
+			t.Errorf("Style(%b) = %q, want %q", tt.attrs, got, tt.want)
+		}
+	}
+
+	// Without sgr, the enter strings follow sgr0.
+	ti = &Terminfo{}
+	ti.Strings[caps.EnterBoldMode] = "B"
+	ti.Strings[caps.EnterUnderlineMode] = "U"
+	ti.Strings[caps.ExitStandoutMode] = "s"
+	ti.Strings[caps.ExitUnderlineMode] = "u"
+	if got := ti.Style(Bold | Underline | Blink); got != "suUB" {
+		t.Errorf("Style without sgr = %q, want %q", got, "suUB")
+	}
+	ti.Strings[caps.ExitAttributeMode] = "0"
+	if got := ti.StyleReset(); got != "0" {
+		t.Errorf("StyleReset = %q, want sgr0", got)
+	}
+
+	var b bytes.Buffer
+	term, err := Setup(&b, "xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	term.SetAttrs(Bold)
+	if !term.Modes.Has(ModeAttributes) || b.String() != "\x1b(B\x1b[0;1m" {
+		t.Errorf("SetAttrs wrote %q", b.String())
+	}
+}
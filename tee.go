@@ -0,0 +1,91 @@
+package terminfo
+
+import "io"
+
+// TokenFunc writes tok to w, encoded for a destination of a Tee.
+type TokenFunc func(w io.Writer, tok Token) error
+
+// StripTokens writes text, newlines and tabs, dropping all other control
+// characters and escape sequences. It suits plain log files.
+func StripTokens(w io.Writer, tok Token) error {
+	if tok.Kind == TokenText || tok.Data == "\n" || tok.Data == "\t" {
+		_, err := io.WriteString(w, tok.Data)
+		return err
+	}
+	return nil
+}
+
+// teeDest is a destination of a Tee.
+type teeDest struct {
+	w   io.Writer
+	enc TokenFunc
+}
+
+// Tee is a writer that copies the output of a program to several
+// destinations, such as a terminal and a log file, each with its own
+// encoding of the capabilities in the output.
+type Tee struct {
+	tok   *Tokenizer
+	dests []teeDest
+}
+
+// NewTee returns a Tee tokenizing the output with the capabilities of ti.
+func (ti *Terminfo) NewTee() *Tee {
+	return &Tee{tok: ti.NewTokenizer()}
+}
+
+// Add adds a destination. The output is written to w as is if enc is nil,
+// and tokenized and encoded with enc otherwise.
+func (t *Tee) Add(w io.Writer, enc TokenFunc) {
+	t.dests = append(t.dests, teeDest{w, enc})
+}
+
+// Write writes b to all destinations. An incomplete escape sequence at the
+// end of b is held back from the encoded destinations until the next Write or
+// Flush. All destinations are written to even if one fails, and the first
+// error is returned.
+func (t *Tee) Write(b []byte) (int, error) {
+	var err error
+	var toks []Token
+	tokenized := false
+	for _, d := range t.dests {
+		if d.enc == nil {
+			if _, werr := d.w.Write(b); werr != nil && err == nil {
+				err = werr
+			}
+			continue
+		}
+		if !tokenized {
+			toks, tokenized = t.tok.Feed(b), true
+		}
+		if werr := encodeTokens(d, toks); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	return len(b), err
+}
+
+// Flush writes the held back output to the encoded destinations.
+func (t *Tee) Flush() error {
+	toks := t.tok.Flush()
+	var err error
+	for _, d := range t.dests {
+		if d.enc == nil {
+			continue
+		}
+		if werr := encodeTokens(d, toks); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	return err
+}
+
+// encodeTokens writes the tokens to the destination.
+func encodeTokens(d teeDest, toks []Token) error {
+	for _, tok := range toks {
+		if err := d.enc(d.w, tok); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package terminfo
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestTokenizer(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tz := ti.NewTokenizer()
+	toks := tz.Feed([]byte("a\x1b[Hb\x1b]0;title\x07é\r\n\x1b[3"))
+	want := []Token{
+		{Kind: TokenText, Data: "a"},
+		{Kind: TokenSequence, Data: "\x1b[H", Cap: "home"},
+		{Kind: TokenText, Data: "b"},
+		{Kind: TokenSequence, Data: "\x1b]0;title\x07"},
+		{Kind: TokenText, Data: "é"},
+		{Kind: TokenControl, Data: "\r", Cap: "cr"},
+		{Kind: TokenControl, Data: "\n", Cap: "cud1"},
+	}
+	if !reflect.DeepEqual(toks, want) {
+		t.Errorf("got %+v\nwant %+v", toks, want)
+	}
+	toks = tz.Feed([]byte("1m\x1b(B"))
+	want = []Token{
+		{Kind: TokenSequence, Data: "\x1b[31m"},
+		{Kind: TokenSequence, Data: "\x1b(B", Cap: "rmacs"},
+	}
+	if !reflect.DeepEqual(toks, want) {
+		t.Errorf("got %+v\nwant %+v", toks, want)
+	}
+	tz.Feed([]byte("\x1b["))
+	if toks = tz.Flush(); len(toks) != 1 || toks[0].Data != "\x1b[" {
+		t.Errorf("Flush returned %+v", toks)
+	}
+}
+
+func TestTee(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var term, log bytes.Buffer
+	tee := ti.NewTee()
+	tee.Add(&term, nil)
+	tee.Add(&log, StripTokens)
+	tee.Write([]byte("\x1b[1mbold\x1b[m\tx\r\n\x1b[3"))
+	tee.Write([]byte("1mred"))
+	if err = tee.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "\x1b[1mbold\x1b[m\tx\r\n\x1b[31mred"; term.String() != want {
+		t.Errorf("terminal got %q, want %q", term.String(), want)
+	}
+	if want := "bold\tx\nred"; log.String() != want {
+		t.Errorf("log got %q, want %q", log.String(), want)
+	}
+}
@@ -0,0 +1,159 @@
+package terminfo
+
+import (
+	"io"
+	"sync"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// DefaultBaud is the Term.Baud set by Setup, the speed reported by most
+// pseudo terminals.
+const DefaultBaud = 38400
+
+// Term writes capabilities of a terminal to its output, applying padding.
+type Term struct {
+	*Terminfo
+	// Padding is how delays are carried out.
+	Padding PadStrategy
+	// Baud is the output speed used to compute padding.
+	Baud int
+	// PadChar overrides the padding character of the terminal if not empty.
+	PadChar string
+	// Modes are the modes turned on through t, which Restore turns off.
+	Modes Modes
+
+	w    io.Writer
+	full *Terminfo
+	// lines and cols are the size of the terminal, see WinSize. lines is
+	// used for delays proportional to the number of lines affected, such as
+	// when clearing.
+	sizeMu      sync.Mutex
+	lines, cols int
+}
+
+// Setup loads the entry with the name, or the entry for $TERM if name is
+// empty, and returns a Term writing to w. The size is taken from the lines
+// and cols capabilities.
+func Setup(w io.Writer, name string) (*Term, error) {
+	var (
+		ti  *Terminfo
+		err error
+	)
+	if name == "" {
+		ti, err = LoadEnv()
+	} else {
+		ti, err = Load(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Term{
+		Terminfo: ti,
+		Baud:     DefaultBaud,
+		lines:    int(ti.Numbers[caps.Lines]),
+		cols:     int(ti.Numbers[caps.Columns]),
+		w:        w,
+		full:     ti,
+	}, nil
+}
+
+// SetConformance restricts the capabilities used by t to the conformance
+// level, see Terminfo.Conform. ConformFull restores the entry.
+func (t *Term) SetConformance(level Conformance) {
+	t.Terminfo = t.full.Conform(level)
+}
+
+// Write writes b to the output of t as is.
+func (t *Term) Write(b []byte) (int, error) {
+	return t.w.Write(b)
+}
+
+// PutCap writes the string capability at i, evaluated with the parameters if
+// there are any, with padding for the baud rate and lines of t.
+// Nothing is written if the terminal lacks the capability.
+func (t *Term) PutCap(i int, p ...interface{}) error {
+	s := t.str(i)
+	if s == "" {
+		return nil
+	}
+	if len(p) > 0 {
+		s = Parm(s, p...)
+	}
+	return t.putTo(t.w, s, MandatoryDelays[i])
+}
+
+// putTo writes s to w with padding for the baud rate and lines of t.
+func (t *Term) putTo(w io.Writer, s string, mandatory bool) error {
+	lines, _ := t.WinSize()
+	_, err := t.PutsOpts(w, s, PadOptions{
+		Strategy:  t.Padding,
+		Baud:      t.Baud,
+		Lines:     lines,
+		PadChar:   t.PadChar,
+		Mandatory: mandatory,
+	})
+	return err
+}
+
+// EnterCA switches to the alternate screen used by full screen programs.
+func (t *Term) EnterCA() error {
+	return t.SetMode(ModeCA, true)
+}
+
+// ExitCA switches back from the alternate screen.
+func (t *Term) ExitCA() error {
+	return t.SetMode(ModeCA, false)
+}
+
+// EnterXon turns on xon/xoff handshaking, for terminals that can, see
+// Terminfo.FlowControl.
+func (t *Term) EnterXon() error {
+	return t.SetMode(ModeXon, true)
+}
+
+// ExitXon turns off xon/xoff handshaking.
+func (t *Term) ExitXon() error {
+	return t.SetMode(ModeXon, false)
+}
+
+// HideCursor makes the cursor invisible.
+func (t *Term) HideCursor() error {
+	return t.SetMode(ModeCursorHidden, true)
+}
+
+// ShowCursor makes the cursor visible again.
+func (t *Term) ShowCursor() error {
+	return t.SetMode(ModeCursorHidden, false)
+}
+
+// Clear clears the screen and moves the cursor to the upper left corner.
+func (t *Term) Clear() error {
+	return t.PutCap(caps.ClearScreen)
+}
+
+// MoveTo moves the cursor to the row and column, see Terminfo.Goto.
+func (t *Term) MoveTo(row, col int) error {
+	return t.PutCap(caps.CursorAddress, row, col)
+}
+
+// SetStyle turns off all attributes and then turns on the given ones, see
+// SetAttrs.
+func (t *Term) SetStyle(bold, underline, reverse bool) error {
+	var attrs Attr
+	if bold {
+		attrs |= Bold
+	}
+	if underline {
+		attrs |= Underline
+	}
+	if reverse {
+		attrs |= Reverse
+	}
+	return t.SetAttrs(attrs)
+}
+
+// ResetStyle turns off all attributes.
+func (t *Term) ResetStyle() error {
+	return t.SetMode(ModeAttributes, false)
+}
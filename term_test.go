@@ -0,0 +1,173 @@
+package terminfo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestTerm(t *testing.T) {
+	var b bytes.Buffer
+	term, err := Setup(&b, "xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines, cols := term.WinSize(); lines != 24 || cols != 80 {
+		t.Errorf("size = %dx%d, want 24x80", lines, cols)
+	}
+	term.EnterCA()
+	term.HideCursor()
+	term.MoveTo(1, 2)
+	term.SetStyle(true, false, true)
+	term.Clear()
+	term.ExitCA()
+	want := "\x1b[?1049h\x1b[22;0;0t" + "\x1b[?25l" + "\x1b[2;3H" + "\x1b(B\x1b[0;1;7m" + "\x1b[H\x1b[2J" + "\x1b[?1049l\x1b[23;0;0t"
+	if b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+
+	b.Reset()
+	term.SetConformance(ConformECMA48)
+	term.EnterCA()
+	if b.Len() != 0 || term.Strings[caps.CursorAddress] == "" {
+		t.Errorf("ECMA-48 conformance kept smcup: %q", b.String())
+	}
+	term.SetConformance(ConformFull)
+	if term.Strings[caps.EnterCaMode] == "" {
+		t.Error("ConformFull did not restore the entry")
+	}
+}
+
+func TestTermPadding(t *testing.T) {
+	var b bytes.Buffer
+	term, err := Setup(&b, "vt100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// vt100 clears with \E[H\E[J$<50>, which is padded when the terminal
+	// does not use xon/xoff, even if it needs it.
+	ti := *term.Terminfo
+	ti.Bools[caps.XonXoff] = false
+	ti.Bools[caps.NeedsXonXoff] = true
+	ti.Numbers[caps.PaddingBaudRate] = 1200
+	term.Terminfo = &ti
+	term.Baud = 9600
+	term.Clear()
+	if want := "\x1b[H\x1b[J" + string(make([]byte, 53)); b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+
+	// The delay of bel is carried out even with xon/xoff.
+	b.Reset()
+	ti.Bools[caps.XonXoff] = true
+	ti.Strings[caps.Bell] = "\a$<10>"
+	term.PadChar = "*"
+	term.PutCap(caps.Bell)
+	term.Clear()
+	if want := "\a***********\x1b[H\x1b[J"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestTermRestore(t *testing.T) {
+	var b bytes.Buffer
+	term, err := Setup(&b, "xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	term.EnterCA()
+	term.HideCursor()
+	term.SetMode(ModeKeypad, true)
+	term.SetMode(ModeBracketedPaste, true)
+	term.SetStyle(true, false, false)
+	term.ShowCursor()
+	if want := ModeCA | ModeKeypad | ModeBracketedPaste | ModeAttributes; term.Modes.On() != want {
+		t.Errorf("modes = %b, want %b", term.Modes.On(), want)
+	}
+	var r bytes.Buffer
+	if err := term.Restore(&r); err != nil {
+		t.Fatal(err)
+	}
+	want := "\x1b(B\x1b[m" + "\x1b[?2004l" + "\x1b[?1l\x1b>" + "\x1b[?1049l\x1b[23;0;0t"
+	if r.String() != want {
+		t.Errorf("Restore wrote %q, want %q", r.String(), want)
+	}
+	r.Reset()
+	term.Restore(&r)
+	if r.Len() != 0 || term.Modes.On() != 0 {
+		t.Errorf("second Restore wrote %q", r.String())
+	}
+
+	vt, err := Setup(&b, "vt100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vt.EnableBracketedPaste() != "" {
+		t.Error("vt100 has bracketed paste")
+	}
+	vt.SetMode(ModeBracketedPaste, true)
+	if vt.Modes.Has(ModeBracketedPaste) {
+		t.Error("mode recorded without writing it")
+	}
+}
+
+func TestSize(t *testing.T) {
+	for _, k := range []string{"LINES", "COLUMNS"} {
+		defer os.Setenv(k, os.Getenv(k))
+		os.Unsetenv(k)
+	}
+	ti := &Terminfo{}
+	ti.Numbers[caps.Lines] = 24
+	ti.Numbers[caps.Columns] = 80
+	// A pipe is not a terminal, so the environment and capabilities are used.
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	if rows, cols, err := ti.Size(w); rows != 24 || cols != 80 || err != nil {
+		t.Errorf("Size = %d, %d, %v; want 24, 80", rows, cols, err)
+	}
+	os.Setenv("COLUMNS", "132")
+	if rows, cols, err := ti.Size(nil); rows != 24 || cols != 132 || err != nil {
+		t.Errorf("Size with $COLUMNS = %d, %d, %v; want 24, 132", rows, cols, err)
+	}
+	ti.Numbers[caps.Lines] = 0
+	if _, _, err := ti.Size(nil); err != ErrUnknownSize {
+		t.Errorf("got error %v, want ErrUnknownSize", err)
+	}
+
+	term := &Term{Terminfo: &Terminfo{}}
+	term.Numbers[caps.Lines] = 50
+	term.Numbers[caps.Columns] = 100
+	var got [2]int
+	stop := term.WatchSize(nil, func(lines, cols int) { got = [2]int{lines, cols} })
+	stop()
+	stop()
+	if lines, cols := term.WinSize(); got != [2]int{50, 132} || lines != 50 || cols != 132 {
+		t.Errorf("WatchSize reported %v and set %dx%d", got, lines, cols)
+	}
+	term.SetSize(10, 20)
+	if lines, cols := term.WinSize(); lines != 10 || cols != 20 {
+		t.Errorf("SetSize set %dx%d, want 10x20", lines, cols)
+	}
+
+	// The size may be updated while writing, run with -race.
+	term.w = ioutil.Discard
+	term.Strings[caps.ClearScreen] = "\x1b[H\x1b[J$<1*>"
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			term.UpdateSize(nil)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		term.PutCap(caps.ClearScreen)
+	}
+	<-done
+}
@@ -0,0 +1,546 @@
+package terminfo
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// These are the termcap errors.
+var (
+	ErrBadTermcap      = errors.New("terminfo: bad termcap entry")
+	ErrTermcapNotFound = errors.New("terminfo: termcap entry not found")
+)
+
+// ToTermcap returns an equivalent termcap entry for ti.
+// Parameterized strings are translated to the termcap % forms. Capabilities
+// that cannot be expressed in termcap, such as strings using conditionals,
+// are left out, as are extended capabilities whose names are not two
+// characters long.
+func (ti *Terminfo) ToTermcap() string {
+	var fields []string
+	for i, v := range ti.Bools {
+		if v && caps.BoolCodes[i] != "" {
+			fields = append(fields, caps.BoolCodes[i])
+		}
+	}
+	for i, v := range ti.Numbers {
+		if v != 0 && caps.NumberCodes[i] != "" {
+			fields = append(fields, caps.NumberCodes[i]+"#"+strconv.Itoa(int(v)))
+		}
+	}
+	for i, v := range ti.Strings {
+		if v == "" || caps.StringCodes[i] == "" {
+			continue
+		}
+		if s, ok := infoToCap(v); ok {
+			fields = append(fields, caps.StringCodes[i]+"="+s)
+		}
+	}
+	for _, k := range sortedKeys(ti.ExtBools) {
+		if len(k) == 2 && ti.ExtBools[k] {
+			fields = append(fields, k)
+		}
+	}
+	for _, k := range sortedKeys(ti.ExtNumbers) {
+		if len(k) == 2 {
+			fields = append(fields, k+"#"+strconv.Itoa(int(ti.ExtNumbers[k])))
+		}
+	}
+	for _, k := range sortedKeys(ti.ExtStrings) {
+		if len(k) != 2 {
+			continue
+		}
+		if s, ok := infoToCap(ti.ExtStrings[k]); ok {
+			fields = append(fields, k+"="+s)
+		}
+	}
+	// Lay out the entry like infocmp -C, continuing long lines.
+	var b strings.Builder
+	b.WriteString(strings.Join(ti.Names, "|"))
+	b.WriteString(":")
+	line := 0
+	for _, f := range fields {
+		if line == 0 || line+len(f)+1 > 64 {
+			b.WriteString("\\\n\t:")
+			line = 1
+		}
+		b.WriteString(f)
+		b.WriteString(":")
+		line += len(f) + 1
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// infoToCap translates a terminfo string into an escaped termcap string.
+// ok is false if the string cannot be expressed in termcap.
+func infoToCap(s string) (string, bool) {
+	s, delay := extractDelay(s)
+	var b strings.Builder
+	var order []int
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			b.WriteString(capEscape(s[i]))
+			continue
+		}
+		rest := s[i:]
+		switch {
+		case strings.HasPrefix(rest, "%%"):
+			b.WriteString("%%")
+			i++
+		case strings.HasPrefix(rest, "%i"):
+			b.WriteString("%i")
+			i++
+		case len(rest) >= 4 && rest[1] == 'p' && rest[2] >= '1' && rest[2] <= '9':
+			order = append(order, int(rest[2]-'0'))
+			conv, n := capConversion(rest[3:])
+			if n == 0 {
+				return "", false
+			}
+			b.WriteString(conv)
+			i += 2 + n
+		default:
+			return "", false
+		}
+	}
+	cs := b.String()
+	// Termcap consumes the parameters in order, except that %r swaps the
+	// first two.
+	if len(order) >= 2 && order[0] == 2 && order[1] == 1 {
+		order[0], order[1] = 1, 2
+		cs = "%r" + cs
+	}
+	for i, p := range order {
+		if p != i+1 {
+			return "", false
+		}
+	}
+	return delay + cs, true
+}
+
+// capConversion translates the terminfo conversion following a %pN
+// into a termcap conversion. n is the number of bytes translated,
+// 0 if the conversion cannot be translated.
+func capConversion(s string) (conv string, n int) {
+	switch {
+	case strings.HasPrefix(s, "%d"):
+		return "%d", 2
+	case strings.HasPrefix(s, "%2d"):
+		return "%2", 3
+	case strings.HasPrefix(s, "%3d"):
+		return "%3", 3
+	case strings.HasPrefix(s, "%c"):
+		return "%.", 2
+	case len(s) >= 7 && strings.HasPrefix(s, "%'") && s[3] == '\'' && strings.HasPrefix(s[4:], "%+%c"):
+		return "%+" + capEscape(s[2]), 8
+	case strings.HasPrefix(s, "%{"):
+		end := strings.IndexByte(s, '}')
+		if end == -1 || !strings.HasPrefix(s[end+1:], "%+%c") {
+			return "", 0
+		}
+		c, err := strconv.Atoi(s[2:end])
+		if err != nil || c < 0 || c > 255 {
+			return "", 0
+		}
+		return "%+" + capEscape(byte(c)), end + 5
+	}
+	return "", 0
+}
+
+// extractDelay removes the padding from s. If s contains a single padding
+// specification, it is returned in the termcap form, which precedes the string.
+func extractDelay(s string) (stripped, delay string) {
+	start := strings.Index(s, "$<")
+	if start == -1 {
+		return s, ""
+	}
+	end := strings.IndexByte(s[start:], '>')
+	if end == -1 {
+		return s, ""
+	}
+	end += start
+	delay = strings.TrimRight(s[start+2:end], "/")
+	stripped = s[:start] + s[end+1:]
+	if strings.Contains(stripped, "$<") {
+		// Termcap only supports a single delay.
+		delay = ""
+	}
+	return stripped, delay
+}
+
+// capEscape escapes the byte c for use in a termcap string.
+func capEscape(c byte) string {
+	switch {
+	case c == '\x1b':
+		return `\E`
+	case c == ':':
+		return `\072`
+	case c == '\\':
+		return `\\`
+	case c == '^':
+		return `\^`
+	case c == 0:
+		return `\200`
+	case c < ' ':
+		return "^" + string(c+'@')
+	case c == 0x7f:
+		return "^?"
+	case c > 0x7f:
+		return `\` + strconv.FormatInt(int64(c), 8)
+	}
+	return string(c)
+}
+
+// ParseTermcap parses a single termcap entry, as found in $TERMCAP.
+// Parameterized strings are translated to their terminfo forms, strings that
+// cannot be translated are left out. tc= references are resolved in the
+// directories of SearchPath.
+func ParseTermcap(entry string) (*Terminfo, error) {
+	return parseTermcap(entry, defaultLoader.load)
+}
+
+// parseTermcap parses a termcap entry, resolving tc= references with resolve.
+func parseTermcap(entry string, resolve func(string) (*Terminfo, error)) (*Terminfo, error) {
+	fields := splitTermcap(entry)
+	if len(fields) == 0 || fields[0] == "" {
+		return nil, ErrBadTermcap
+	}
+	ti := &Terminfo{
+		Names:      strings.Split(fields[0], "|"),
+		ExtBools:   make(map[string]bool),
+		ExtNumbers: make(map[string]int16),
+		ExtStrings: make(map[string]string),
+	}
+	ti.Origins = []Origin{{Kind: "termcap", Name: ti.Names[0]}}
+	// Capabilities that were set or cancelled, so tc= does not override them.
+	seen := make(map[string]bool)
+	var uses []string
+	for _, f := range fields[1:] {
+		if len(f) < 2 {
+			continue
+		}
+		code := f[:2]
+		switch {
+		case code == "tc" && len(f) > 3 && f[2] == '=':
+			uses = append(uses, f[3:])
+			continue
+		case seen[code]:
+			continue
+		}
+		seen[code] = true
+		switch {
+		case len(f) == 2:
+			if i, ok := caps.LookupBoolCode(code); ok {
+				ti.Bools[i] = true
+			} else {
+				ti.ExtBools[code] = true
+			}
+		case f[2] == '@':
+			// Cancelled.
+		case f[2] == '#':
+			n, err := strconv.ParseInt(f[3:], 0, 16)
+			if err != nil {
+				return nil, ErrBadTermcap
+			}
+			if i, ok := caps.LookupNumberCode(code); ok {
+				ti.Numbers[i] = int16(n)
+			} else {
+				ti.ExtNumbers[code] = int16(n)
+			}
+		case f[2] == '=':
+			s, ok := capToInfo(capUnescape(f[3:]))
+			if !ok {
+				continue
+			}
+			if i, ok := caps.LookupStringCode(code); ok {
+				ti.Strings[i] = s
+			} else {
+				ti.ExtStrings[code] = s
+			}
+		default:
+			seen[code] = false
+		}
+	}
+	for _, name := range uses {
+		use, err := resolve(name)
+		if err != nil {
+			return nil, err
+		}
+		fillTermcap(ti, use, seen)
+	}
+	return ti, nil
+}
+
+// fillTermcap copies the capabilities of use that were not seen into ti.
+func fillTermcap(ti, use *Terminfo, seen map[string]bool) {
+	off := ti.addOrigins(use)
+	for i, v := range use.Bools {
+		if c := caps.BoolCodes[i]; !seen[c] && v {
+			ti.Bools[i], seen[c] = true, true
+			ti.setOrigin(caps.BoolNames[i], use, off)
+		}
+	}
+	for i, v := range use.Numbers {
+		if c := caps.NumberCodes[i]; !seen[c] && v != 0 {
+			ti.Numbers[i], seen[c] = v, true
+			ti.setOrigin(caps.NumberNames[i], use, off)
+		}
+	}
+	for i, v := range use.Strings {
+		if c := caps.StringCodes[i]; !seen[c] && v != "" {
+			ti.Strings[i], seen[c] = v, true
+			ti.setOrigin(caps.StringNames[i], use, off)
+		}
+	}
+	for k, v := range use.ExtBools {
+		if !seen[k] {
+			ti.ExtBools[k], seen[k] = v, true
+			ti.setOrigin(k, use, off)
+		}
+	}
+	for k, v := range use.ExtNumbers {
+		if !seen[k] {
+			ti.ExtNumbers[k], seen[k] = v, true
+			ti.setOrigin(k, use, off)
+		}
+	}
+	for k, v := range use.ExtStrings {
+		if !seen[k] {
+			ti.ExtStrings[k], seen[k] = v, true
+			ti.setOrigin(k, use, off)
+		}
+	}
+}
+
+// splitTermcap splits an entry into its fields, joining continuation lines.
+func splitTermcap(entry string) []string {
+	entry = strings.Replace(entry, "\\\n", "", -1)
+	var fields []string
+	start := 0
+	for i := 0; i < len(entry); i++ {
+		switch entry[i] {
+		case '\\':
+			i++
+		case ':':
+			fields = append(fields, strings.TrimSpace(entry[start:i]))
+			start = i + 1
+		}
+	}
+	if f := strings.TrimSpace(entry[start:]); f != "" {
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// capUnescape decodes the escapes of a termcap string.
+func capUnescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '^' && i+1 < len(s):
+			i++
+			if s[i] == '?' {
+				b.WriteByte(0x7f)
+			} else {
+				b.WriteByte(s[i] & 0x1f)
+			}
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch c = s[i]; c {
+			case 'E', 'e':
+				b.WriteByte('\x1b')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				n := 0
+				for j := 0; j < 3 && i < len(s) && s[i] >= '0' && s[i] <= '7'; j++ {
+					n = n*8 + int(s[i]-'0')
+					i++
+				}
+				i--
+				if n == 0200 {
+					n = 0
+				}
+				b.WriteByte(byte(n))
+			default:
+				b.WriteByte(c)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// capToInfo translates an unescaped termcap string into a terminfo string.
+// ok is false if the string uses % operations that cannot be translated.
+func capToInfo(s string) (string, bool) {
+	// A leading number is the delay.
+	var delay string
+	for len(delay) < len(s) {
+		c := s[len(delay)]
+		if !(c >= '0' && c <= '9' || c == '.' || c == '*') {
+			break
+		}
+		delay = s[:len(delay)+1]
+	}
+	s = s[len(delay):]
+	order := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	param := 0
+	next := func() string {
+		p := order[param%len(order)]
+		param++
+		return "%p" + strconv.Itoa(p)
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		if i >= len(s) {
+			return "", false
+		}
+		switch s[i] {
+		case '%':
+			b.WriteString("%%")
+		case 'd':
+			b.WriteString(next() + "%d")
+		case '2':
+			b.WriteString(next() + "%2d")
+		case '3':
+			b.WriteString(next() + "%3d")
+		case '.':
+			b.WriteString(next() + "%c")
+		case '+':
+			i++
+			if i >= len(s) {
+				return "", false
+			}
+			b.WriteString(next() + "%'" + string(s[i]) + "'%+%c")
+		case 'i':
+			b.WriteString("%i")
+		case 'r':
+			order[0], order[1] = 2, 1
+		default:
+			return "", false
+		}
+	}
+	if delay != "" {
+		b.WriteString("$<" + delay + ">")
+	}
+	return b.String(), true
+}
+
+// loadTermcap loads the entry from $TERMCAP, which is either an entry or
+// the path of a termcap database. tc= references are resolved in $TERMCAP
+// first and then in the directories of SearchPath.
+func loadTermcap(name string) (*Terminfo, error) {
+	tc := os.Getenv("TERMCAP")
+	if tc == "" {
+		return nil, ErrTermcapNotFound
+	}
+	db := tc
+	if strings.HasPrefix(tc, "/") {
+		b, err := ioutil.ReadFile(tc)
+		if err != nil {
+			return nil, err
+		}
+		db = string(b)
+	}
+	entries := termcapEntries(db)
+	// Entries being resolved, to detect tc= loops.
+	visiting := map[string]bool{name: true}
+	var resolve func(string) (*Terminfo, error)
+	resolve = func(name string) (*Terminfo, error) {
+		e, ok := entries[name]
+		if !ok {
+			return defaultLoader.load(name)
+		}
+		if visiting[name] {
+			return nil, ErrBadTermcap
+		}
+		visiting[name] = true
+		defer delete(visiting, name)
+		return parseTermcap(e, resolve)
+	}
+	e, ok := entries[name]
+	if !ok {
+		return nil, ErrTermcapNotFound
+	}
+	return parseTermcap(e, resolve)
+}
+
+// termcapEntries splits a termcap database into its entries, keyed by each
+// of their names.
+func termcapEntries(db string) map[string]string {
+	entries := make(map[string]string)
+	var cur strings.Builder
+	flush := func() {
+		e := cur.String()
+		cur.Reset()
+		if e == "" {
+			return
+		}
+		names := e
+		if i := strings.IndexByte(e, ':'); i != -1 {
+			names = e[:i]
+		}
+		for _, n := range strings.Split(names, "|") {
+			if _, ok := entries[n]; !ok {
+				entries[n] = e
+			}
+		}
+	}
+	for _, line := range strings.Split(db, "\n") {
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		if strings.HasSuffix(line, "\\") {
+			cur.WriteString(line[:len(line)-1])
+			continue
+		}
+		cur.WriteString(line)
+		flush()
+	}
+	flush()
+	return entries
+}
+
+// sortedKeys returns the keys of m, which must be one of the extended
+// capability maps, in sorted order.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]bool:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]int16:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]string:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
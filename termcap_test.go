@@ -0,0 +1,91 @@
+package terminfo
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestTermcapRoundTrip(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc, err := ParseTermcap(ti.ToTermcap())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range []int{caps.CursorAddress, caps.ClearScreen, caps.KeyUp, caps.EnterBoldMode} {
+		if tc.Strings[i] != ti.Strings[i] {
+			t.Errorf("%s = %q, want %q", caps.StringNames[i], tc.Strings[i], ti.Strings[i])
+		}
+	}
+	if tc.Numbers[caps.Columns] != ti.Numbers[caps.Columns] || tc.Bools[caps.AutoRightMargin] != ti.Bools[caps.AutoRightMargin] {
+		t.Error("numbers or booleans were not preserved")
+	}
+}
+
+func TestCapToInfo(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"\x1b[%i%d;%dH", "\x1b[%i%p1%d;%p2%dH"},
+		{"\x1b=%+ %+ ", "\x1b=%p1%' '%+%c%p2%' '%+%c"},
+		{"%r%dX%d", "%p2%dX%p1%d"},
+		{"5\x1b[H", "\x1b[H$<5>"},
+	}
+	for _, tt := range tests {
+		if got, ok := capToInfo(tt.in); !ok || got != tt.want {
+			t.Errorf("capToInfo(%q) = %q, %v; want %q", tt.in, got, ok, tt.want)
+		}
+		if back, ok := infoToCap(tt.want); !ok || capUnescape(back) != tt.in {
+			t.Errorf("infoToCap(%q) = %q, %v; want %q", tt.want, back, ok, tt.in)
+		}
+	}
+	if _, ok := infoToCap("%?%p1%t;1%;"); ok {
+		t.Error("conditionals cannot be translated")
+	}
+}
+
+func TestLoadTermcap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	db := "# test database\n" +
+		"tc-base|base terminal:co#80:cl=\\E[H\\E[J:am:\n" +
+		"tc-test|test terminal:\\\n\t:co#132:am@:tc=tc-base:\n"
+	path := filepath.Join(dir, "termcap")
+	if err = ioutil.WriteFile(path, []byte(db), 0644); err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("TERMCAP", path)
+	defer os.Unsetenv("TERMCAP")
+	ti, err := Load("tc-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Numbers[caps.Columns] != 132 || ti.Bools[caps.AutoRightMargin] || ti.Strings[caps.ClearScreen] != "\x1b[H\x1b[J" {
+		t.Errorf("unexpected entry: co=%d am=%v cl=%q",
+			ti.Numbers[caps.Columns], ti.Bools[caps.AutoRightMargin], ti.Strings[caps.ClearScreen])
+	}
+}
+
+func TestLoadTermcapInline(t *testing.T) {
+	os.Setenv("TERMCAP", "tc-inline|inline terminal:co#80:tc=tc-missing:")
+	defer os.Unsetenv("TERMCAP")
+	if _, err := Load("tc-other"); err == nil {
+		t.Error("loaded an entry not in $TERMCAP")
+	}
+	if _, err := Load("tc-inline"); err == nil {
+		t.Error("loaded an entry with a missing tc=")
+	}
+	os.Setenv("TERMCAP", "tc-inline|inline terminal:co#80:tc=tc-inline:")
+	if _, err := loadTermcap("tc-inline"); err != ErrBadTermcap {
+		t.Errorf("got %v for a tc= loop; want %v", err, ErrBadTermcap)
+	}
+}
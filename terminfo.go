@@ -4,187 +4,427 @@ package terminfo
 import (
 	"errors"
 	"io"
-	"io/ioutil"
-	"os"
+	"math"
 	"strconv"
 	"strings"
-	"sync"
+	"time"
 
 	"github.com/nhooyr/terminfo/caps"
 )
 
 // Terminfo describes a terminal's capabilities.
 type Terminfo struct {
-	Names      []string
-	Bools      [caps.BoolCount]bool
-	Numbers    [caps.NumberCount]int16
-	Strings    [caps.StringCount]string
+	Names   []string
+	Bools   [caps.BoolCount]bool
+	Numbers [caps.NumberCount]int16
+	Strings [caps.StringCount]string
+
+	// ExtBools, ExtNumbers and ExtStrings hold the extended capabilities,
+	// keyed by name.
+	//
+	// Deprecated: Read them with ExtBool, ExtNumber, ExtString and ExtNames
+	// and change them with Set, which keeps each name to a single type and
+	// allocates the maps as needed. The maps stay exported for existing code.
+	// Neither the maps nor the accessors are safe to use while another
+	// goroutine changes the entry, see ExtBool.
 	ExtBools   map[string]bool
 	ExtNumbers map[string]int16
 	ExtStrings map[string]string
+
+	// Notes are free-form comments about the entry, such as why an override
+	// exists. They are written and read as comments in the source format.
+	Notes []string
+	// CapNotes are comments about individual capabilities, keyed by the
+	// short name of the capability.
+	CapNotes map[string][]string
+
+	// CapStates holds the state of the standard numbers and strings that
+	// are not simply absent although their value in Numbers or Strings is
+	// 0 or empty, keyed by short name: those present with that value and
+	// those cancelled. See Number and the string accessors such as
+	// CursorAddress.
+	CapStates map[string]CapState
+
+	// Origins is the chain of sources the entry was built from. The first
+	// is the entry itself, followed by the entries it took capabilities
+	// from, such as through use= or Compose.
+	Origins []Origin
+	// CapOrigins maps the short names of the capabilities that were not
+	// defined by the first origin to the index of their origin in Origins.
+	CapOrigins map[string]int
+
+	// Layout describes the compiled file the entry was decoded from.
+	// It is only set by DecodeBytesOpts with DecodeOptions.KeepLayout.
+	Layout *Layout
+	// Format is the variant of the compiled format the entry was decoded
+	// from. It is zero for entries in the usual format and those not
+	// decoded, and is not used by Encode, which always writes the usual
+	// format.
+	Format Format
+
+	parms *parmCache // results of ParmCached
+}
+
+// Color takes a foreground and background color and returns string
+// that sets them for this terminal.
+// Colors the palette of the terminal cannot hold are sent as 24-bit colors
+// from the xterm palette if the terminal supports them, see DirectColor.
+// Otherwise they are approximated with the nearest color of the palette.
+// TODO redo with styles integer
+func (ti *Terminfo) Color(fg, bg int) string {
+	var b strings.Builder
+	ti.ColorTo(&b, fg, bg)
+	return b.String()
+}
+
+// ColorTo is like Color but writes the string to w.
+func (ti *Terminfo) ColorTo(w io.Writer, fg, bg int) error {
+	if err := ti.colorTo(w, fg, caps.SetAForeground, "setrgbf", 38); err != nil {
+		return err
+	}
+	return ti.colorTo(w, bg, caps.SetABackground, "setrgbb", 48)
 }
 
-// Terminfo cache.
+// These are the errors returned by Terminfo.ParmErr for capabilities that
+// cannot be evaluated.
 var (
-	dbMutex sync.RWMutex
-	db      = make(map[string]*Terminfo)
+	ErrCapIndex  = errors.New("terminfo: string capability index out of range")
+	ErrCapAbsent = errors.New("terminfo: string capability absent")
 )
 
-// LoadEnv calls Load with the name as $TERM.
-func LoadEnv() (*Terminfo, error) {
-	return Load(os.Getenv("TERM"))
+// str returns the string in ti.Strings at i, or an empty string if i is
+// out of range.
+func (ti *Terminfo) str(i int) string {
+	if i < 0 || i >= len(ti.Strings) {
+		return ""
+	}
+	return ti.Strings[i]
 }
 
-// Returned when no name is provided to Load.
-var ErrEmptyTerm = errors.New("terminfo: empty term name")
+// Parm calls the function Parm with the string in ti.Strings at
+// i and the variadic arguments. It returns an empty string if i is
+// out of range.
+func (ti *Terminfo) Parm(i int, p ...interface{}) string {
+	return Parm(ti.str(i), p...)
+}
 
-// Load follows the behavior described in terminfo(5) to find correct the terminfo file
-// using the name, reads the file and then returns a Terminfo struct that describes the file.
-func Load(name string) (ti *Terminfo, err error) {
-	if name == "" {
-		return nil, ErrEmptyTerm
+// ParmErr calls the function ParmErr with the string in ti.Strings at
+// i and the variadic arguments. It returns ErrCapIndex if i is out of
+// range and ErrCapAbsent if the capability is absent.
+func (ti *Terminfo) ParmErr(i int, p ...interface{}) (string, error) {
+	if i < 0 || i >= len(ti.Strings) {
+		return "", ErrCapIndex
 	}
-	dbMutex.RLock()
-	ti, ok := db[name]
-	dbMutex.RUnlock()
-	if ok {
-		return
+	if ti.Strings[i] == "" {
+		return "", ErrCapAbsent
 	}
-	if terminfo := os.Getenv("TERMINFO"); terminfo != "" {
-		return openDir(terminfo, name)
+	return ParmErr(ti.Strings[i], p...)
+}
+
+// MustParm is like ParmErr but panics if there is an error.
+func (ti *Terminfo) MustParm(i int, p ...interface{}) string {
+	s, err := ti.ParmErr(i, p...)
+	if err != nil {
+		panic(err)
 	}
-	if home := os.Getenv("HOME"); home != "" {
-		ti, err = openDir(home+"/.terminfo", name)
-		if err == nil {
-			return
-		}
+	return s
+}
+
+// ParmOpts calls the function ParmOpts with the string in ti.Strings at
+// i, the options and the variadic arguments. It returns an empty string
+// if i is out of range.
+func (ti *Terminfo) ParmOpts(i int, opts EvalOptions, p ...interface{}) string {
+	return ParmOpts(ti.str(i), opts, p...)
+}
+
+// Padding limits, protecting against corrupt entries and arguments.
+const (
+	// maxDelay is the maximum delay in tenths of milliseconds.
+	maxDelay = 1000000
+	// maxPadding is the maximum number of padding characters emitted for a delay.
+	maxPadding = 1 << 14
+)
+
+// padBaudByte is the number of bits sent per character, used to convert delays
+// into padding characters: 7 data bits, a parity bit and a stop bit, as in ncurses.
+const padBaudByte = 9
+
+// PadStrategy is how PutsOpts carries out delays.
+type PadStrategy int
+
+// These are the padding strategies.
+const (
+	// PadChars sends padding characters, which take the time of the delay
+	// to transmit at the baud rate.
+	PadChars PadStrategy = iota
+	// PadSleep sleeps for the delay. The writer should not buffer the
+	// output, or it should be flushed before each delay.
+	PadSleep
+	// PadNone leaves out delays.
+	PadNone
+)
+
+// PadOptions controls how PutsOpts emits delays.
+type PadOptions struct {
+	Strategy PadStrategy
+	// Baud is the output speed.
+	Baud int
+	// Lines is the number of lines affected, for delays proportional to it.
+	Lines int
+	// PadChar overrides the padding character of the terminal if not empty.
+	// Only its first byte is used.
+	PadChar string
+	// Mandatory carries out all delays as if they were followed by /, even
+	// when the baud rate or xon/xoff would skip them.
+	Mandatory bool
+}
+
+// MandatoryDelays holds the string capabilities whose delays are always
+// carried out by Term.PutCap, like ncurses does. The delays of bel and flash
+// give the user time to notice them on hardware terminals rather than time for
+// the terminal to catch up, so they do not depend on the baud rate.
+var MandatoryDelays = map[int]bool{
+	caps.Bell:        true,
+	caps.FlashScreen: true,
+}
+
+// sleep is time.Sleep, replaced in tests.
+var sleep = time.Sleep
+
+// Puts emits the string to the writer, but expands inline padding
+// indications (of the form $<[delay]> where [delay] is msec) to
+// a suitable number of padding characters (usually null bytes) based
+// upon the supplied baud.  At high baud rates, more padding characters
+// will be inserted. It returns the number of bytes written and the first
+// write error, see PutsOpts.
+func (ti *Terminfo) Puts(w io.Writer, s string, lines, baud int) (int, error) {
+	return ti.PutsOpts(w, s, PadOptions{Baud: baud, Lines: lines})
+}
+
+// PutsOpts emits the string to the writer, carrying out inline padding
+// indications with the strategy of the options. It returns the number of
+// bytes written, padding included, and stops at the first write error.
+//
+// The computation follows ncurses: delays may have a single decimal digit,
+// are multiplied by lines when followed by *, and are only emitted when
+// the terminal does not use or need xon/xoff and baud is at least the
+// padding baud rate, unless they are mandatory (followed by /). A lines of
+// 0 or less counts as a single line. Padding characters are computed from
+// the delay in tenths of milliseconds and rounded to the nearest count, so
+// that fractional delays are not lost at low baud rates.
+func (ti *Terminfo) PutsOpts(w io.Writer, s string, opts PadOptions) (n int, err error) {
+	write := func(s string) error {
+		m, err := io.WriteString(w, s)
+		n += m
+		return err
 	}
-	if dirs := os.Getenv("TERMINFO_DIRS"); dirs != "" {
-		for _, dir := range strings.Split(dirs, ":") {
-			if dir == "" {
-				dir = "/usr/share/terminfo"
-			}
-			ti, err = openDir(dir, name)
-			if err == nil {
-				return
+	for {
+		start := strings.Index(s, "$<")
+		if start == -1 {
+			// Most strings don't need padding, which is good news!
+			return n, write(s)
+		}
+		if err := write(s[:start]); err != nil {
+			return n, err
+		}
+		s = s[start+2:]
+		end := strings.IndexByte(s, '>')
+		if end == -1 || s[0] != '.' && (s[0] < '0' || s[0] > '9') {
+			// Not a delay... just emit the bytes unadulterated.
+			if err := write("$<"); err != nil {
+				return n, err
 			}
+			continue
 		}
-	}
-	for _, dir := range []string{"/etc/terminfo", "/lib/terminfo"} {
-		ti, err = openDir(dir, name)
-		if err == nil {
-			return
+		tenths, mandatory := parseDelay(s[:end], opts.Lines)
+		mandatory = mandatory || opts.Mandatory
+		s = s[end+1:]
+		if tenths == 0 || !mandatory && !ti.normalDelay(opts.Baud) {
+			continue
+		}
+		switch opts.Strategy {
+		case PadChars:
+			if p := ti.padding(tenths, opts.Baud); p > 0 {
+				if err := write(strings.Repeat(string(ti.padChar(opts.PadChar)), p)); err != nil {
+					return n, err
+				}
+			}
+		case PadSleep:
+			sleep(time.Duration(tenths) * 100 * time.Microsecond)
 		}
 	}
-	return openDir("/usr/share/terminfo", name)
 }
 
-// openDir reads the Terminfo file specified by the dir and name.
-func openDir(dir, name string) (*Terminfo, error) {
-	// Try typical *nix path.
-	b, err := ioutil.ReadFile(dir + "/" + name[0:1] + "/" + name)
-	if err != nil {
-		// Fallback to the darwin specific path.
-		b, err = ioutil.ReadFile(dir + "/" + strconv.FormatUint(uint64(name[0]), 16) + "/" + name)
-		if err != nil {
-			return nil, err
+// parseDelay parses the delay in a padding specification, such as "5.5*/",
+// and returns it in tenths of milliseconds for the number of lines affected.
+func parseDelay(val string, lines int) (tenths int, mandatory bool) {
+	tenths, proportional, mandatory := parseDelaySpec(val)
+	if proportional && lines > 1 {
+		tenths *= lines
+		if tenths > maxDelay {
+			tenths = maxDelay
 		}
 	}
-	r := &decoder{buf: b}
-	if err = r.unmarshal(); err != nil {
-		return nil, err
-	}
-	// Cache the Terminfo struct.
-	dbMutex.Lock()
-	for _, n := range r.ti.Names {
-		db[n] = r.ti
-	}
-	dbMutex.Unlock()
-	return r.ti, nil
+	return tenths, mandatory
 }
 
-// Color takes a foreground and background color and returns string
-// that sets them for this terminal.
-// TODO redo with styles integer
-func (ti *Terminfo) Color(fg, bg int) (rv string) {
-	maxColors := int(ti.Numbers[caps.MaxColors])
-	// Map bright colors to lower versions if the color table only holds 8.
-	if maxColors == 8 {
-		if fg > 7 && fg < 16 {
-			fg -= 8
+// parseDelaySpec parses a padding specification and returns its delay in
+// tenths of milliseconds, whether it is proportional to the number of lines
+// affected (*) and whether it is mandatory (/).
+func parseDelaySpec(val string) (tenths int, proportional, mandatory bool) {
+	i := 0
+	for ; i < len(val) && val[i] >= '0' && val[i] <= '9'; i++ {
+		if tenths < maxDelay {
+			tenths = tenths*10 + int(val[i]-'0')
+		}
+	}
+	tenths *= 10
+	if i < len(val) && val[i] == '.' {
+		i++
+		if i < len(val) && val[i] >= '0' && val[i] <= '9' {
+			tenths += int(val[i] - '0')
 		}
-		if bg > 7 && bg < 16 {
-			bg -= 8
+		for i < len(val) && val[i] >= '0' && val[i] <= '9' {
+			i++
 		}
 	}
-	if maxColors > fg && fg >= 0 {
-		rv += ti.Parm(caps.SetAForeground, fg)
+	for ; i < len(val) && (val[i] == '*' || val[i] == '/'); i++ {
+		if val[i] == '/' {
+			mandatory = true
+		} else {
+			proportional = true
+		}
 	}
-	if maxColors > bg && bg >= 0 {
-		rv += ti.Parm(caps.SetABackground, bg)
+	if tenths > maxDelay {
+		tenths = maxDelay
 	}
-	return
+	return tenths, proportional, mandatory
 }
 
-// Parm calls the function Parm with the string in ti.Strings at
-// i and the variadic arguments.
-func (ti *Terminfo) Parm(i int, p ...interface{}) string {
-	return Parm(ti.Strings[i], p...)
+// Delay is a padding indication in a string, as returned by ParseDelays.
+type Delay struct {
+	// Offset is the position of the delay in the stripped string: the
+	// delay follows the first Offset bytes.
+	Offset int
+	// Duration is the delay, per line affected if Proportional.
+	Duration time.Duration
+	// Proportional is whether the delay is multiplied by the number of
+	// lines affected, written with *.
+	Proportional bool
+	// Mandatory is whether the delay must be carried out even when the
+	// terminal uses xon/xoff, written with /.
+	Mandatory bool
 }
 
-// Puts emits the string to the writer, but expands inline padding
-// indications (of the form $<[delay]> where [delay] is msec) to
-// a suitable number of padding characters (usually null bytes) based
-// upon the supplied baud.  At high baud rates, more padding characters
-// will be inserted.
-func (ti *Terminfo) Puts(w io.Writer, s string, lines, baud int) {
+// For returns the delay when the given number of lines is affected, which
+// counts as a single line if it is 0 or less.
+func (d Delay) For(lines int) time.Duration {
+	if d.Proportional && lines > 1 {
+		if max := time.Duration(maxDelay) * 100 * time.Microsecond; d.Duration*time.Duration(lines) > max {
+			return max
+		}
+		return d.Duration * time.Duration(lines)
+	}
+	return d.Duration
+}
+
+// ParseDelays removes the padding indications ($<delay>) from s and returns
+// them, for callers carrying out delays themselves. Malformed indications
+// are kept in the string, as by Puts.
+func ParseDelays(s string) (stripped string, delays []Delay) {
+	if !strings.Contains(s, "$<") {
+		return s, nil
+	}
+	var b strings.Builder
 	for {
 		start := strings.Index(s, "$<")
 		if start == -1 {
-			// Most strings don't need padding, which is good news!
-			io.WriteString(w, s)
-			return
+			b.WriteString(s)
+			return b.String(), delays
 		}
-		io.WriteString(w, s[:start])
+		b.WriteString(s[:start])
 		s = s[start+2:]
-		end := strings.Index(s, ">")
-		if end == -1 {
-			// Unterminated... just emit bytes unadulterated.
-			io.WriteString(w, "$<"+s)
-			return
+		end := strings.IndexByte(s, '>')
+		if end == -1 || s[0] != '.' && (s[0] < '0' || s[0] > '9') {
+			b.WriteString("$<")
+			continue
 		}
-		val := s[:end]
+		tenths, proportional, mandatory := parseDelaySpec(s[:end])
 		s = s[end+1:]
-		var ms int
-		var dot, mandatory, asterisk bool
-		unit := 1000
-		for _, ch := range val {
-			if ch >= '0' && ch <= '9' {
-				ms = (ms * 10) + int(ch-'0')
-				if dot {
-					unit *= 10
-				}
-			} else if ch == '.' && !dot {
-				dot = true
-			} else if ch == '*' && !asterisk {
-				ms *= lines
-				asterisk = true
-			} else if ch == '/' {
-				mandatory = true
-			} else {
-				break
-			}
-		}
-		n := ((baud / 8) / unit) * ms
-		pad := ti.Strings[caps.PadChar]
-		b := make([]byte, len(pad)*n)
-		for bp := copy(b, pad); bp < len(b); bp *= 2 {
-			copy(b[bp:], b[:bp])
-		}
-		if (!ti.Bools[caps.XonXoff] && baud > int(ti.Numbers[caps.PaddingBaudRate])) || mandatory {
-			w.Write(b)
-		}
+		delays = append(delays, Delay{
+			Offset:       b.Len(),
+			Duration:     time.Duration(tenths) * 100 * time.Microsecond,
+			Proportional: proportional,
+			Mandatory:    mandatory,
+		})
+	}
+}
+
+// normalDelay reports whether delays that are not mandatory should be
+// emitted at the baud rate. Like in ncurses, they are not for terminals
+// using xon/xoff.
+func (ti *Terminfo) normalDelay(baud int) bool {
+	pb := int(ti.Numbers[caps.PaddingBaudRate])
+	return !ti.Bools[caps.XonXoff] && pb > 0 && baud >= pb
+}
+
+// padding returns the number of padding characters needed for a delay of
+// tenths of milliseconds at the baud rate, rounded to the nearest count.
+func (ti *Terminfo) padding(tenths, baud int) int {
+	if ti.Bools[caps.NoPadChar] || tenths <= 0 || baud <= 0 {
+		return 0
+	}
+	if baud > math.MaxInt32 {
+		baud = math.MaxInt32
 	}
+	const d = padBaudByte * 10000
+	n := (int64(tenths)*int64(baud) + d/2) / d
+	if n > maxPadding {
+		return maxPadding
+	}
+	return int(n)
+}
+
+// padChar returns the padding character: the first byte of override if it is
+// not empty, else that of pad, else a null byte.
+func (ti *Terminfo) padChar(override string) byte {
+	if override != "" {
+		return override[0]
+	}
+	if pad := ti.Strings[caps.PadChar]; pad != "" {
+		return pad[0]
+	}
+	return 0
+}
+
+// GetFlag returns the value of the boolean capability with the given short
+// or long name, falling back to the extended capabilities if the name is not
+// a standard one.
+func (ti *Terminfo) GetFlag(name string) bool {
+	if i, ok := caps.LookupBool(name); ok {
+		return ti.Bools[i]
+	}
+	return ti.ExtBool(name)
+}
+
+// GetNumber returns the value of the number capability with the given short
+// or long name, falling back to the extended capabilities if the name is not
+// a standard one. ok is false if the capability is unknown.
+func (ti *Terminfo) GetNumber(name string) (n int, ok bool) {
+	if i, ok := caps.LookupNumber(name); ok {
+		return int(ti.Numbers[i]), true
+	}
+	return ti.ExtNumber(name)
+}
+
+// GetString returns the value of the string capability with the given short
+// or long name, falling back to the extended capabilities if the name is not
+// a standard one. ok is false if the capability is unknown.
+func (ti *Terminfo) GetString(name string) (s string, ok bool) {
+	if i, ok := caps.LookupString(name); ok {
+		return ti.Strings[i], true
+	}
+	return ti.ExtString(name)
 }
 
 // Goto returns a string suitable for addressing the cursor at the given
@@ -192,3 +432,28 @@ func (ti *Terminfo) Puts(w io.Writer, s string, lines, baud int) {
 func (ti *Terminfo) Goto(row, col int) string {
 	return ti.Parm(caps.CursorAddress, row, col)
 }
+
+// xtermCup is the cursor_address of xterm and most terminals emulating it.
+const xtermCup = "\x1b[%i%p1%d;%p2%dH"
+
+// GotoXY is like Goto but builds the string in buf and returns the slice of
+// buf holding it, or a new slice if the string does not fit in buf.
+// For the cursor_address of xterm, \E[%i%p1%d;%p2%dH, and
+// non-negative coordinates, it neither allocates nor evaluates the
+// parameterized string. Otherwise it falls back to Goto and copies its
+// result.
+func (ti *Terminfo) GotoXY(buf *[32]byte, row, col int) []byte {
+	if ti.Strings[caps.CursorAddress] != xtermCup || row < 0 || col < 0 {
+		return append(buf[:0], ti.Goto(row, col)...)
+	}
+	b := append(buf[:0], "\x1b["...)
+	b = strconv.AppendInt(b, int64(row)+1, 10)
+	b = append(b, ';')
+	b = strconv.AppendInt(b, int64(col)+1, 10)
+	return append(b, 'H')
+}
+
+// GotoTo is like Goto but writes the string to w.
+func (ti *Terminfo) GotoTo(w io.Writer, row, col int) error {
+	return ParmTo(w, ti.Strings[caps.CursorAddress], row, col)
+}
@@ -2,7 +2,17 @@ package terminfo
 
 import (
 	"bytes"
+	"errors"
+	"io/fs"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/nhooyr/terminfo/caps"
 )
@@ -47,3 +57,556 @@ func BenchmarkParm(b *testing.B) {
 	}
 	result = r
 }
+
+func TestGetByName(t *testing.T) {
+	ti, err := LoadEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := ti.GetString("cup"); !ok || s != ti.Strings[caps.CursorAddress] {
+		t.Errorf("GetString(cup) = %q, %v", s, ok)
+	}
+	if s, _ := ti.GetString("cursor_address"); s != ti.Strings[caps.CursorAddress] {
+		t.Errorf("GetString(cursor_address) = %q", s)
+	}
+	if n, ok := ti.GetNumber("colors"); !ok || n != int(ti.Numbers[caps.MaxColors]) {
+		t.Errorf("GetNumber(colors) = %d, %v", n, ok)
+	}
+	if b := ti.GetFlag("am"); b != ti.Bools[caps.AutoRightMargin] {
+		t.Errorf("GetFlag(am) = %v", b)
+	}
+	if _, ok := ti.GetString("no-such-capability"); ok {
+		t.Error("GetString found an unknown capability")
+	}
+}
+
+func TestLookupNames(t *testing.T) {
+	for i := range caps.StringNames {
+		for _, name := range []string{caps.StringNames[i], caps.StringLongNames[i]} {
+			if j, ok := caps.LookupString(name); !ok || j != i {
+				t.Errorf("LookupString(%q) = %d, %v, want %d", name, j, ok, i)
+			}
+		}
+	}
+	for i, code := range caps.BoolCodes {
+		if j, ok := caps.LookupBoolCode(code); !ok || caps.BoolCodes[j] != code {
+			t.Errorf("LookupBoolCode(%q) = %d, %v, want %d", code, j, ok, i)
+		}
+	}
+	for _, name := range []string{"", "cupp", "cu", "Tc"} {
+		if _, ok := caps.LookupString(name); ok {
+			t.Errorf("LookupString(%q) found a capability", name)
+		}
+	}
+}
+
+func TestCapsMetadata(t *testing.T) {
+	if n := len(caps.All); n != caps.BoolCount+caps.NumberCount+caps.StringCount {
+		t.Errorf("len(caps.All) = %d", n)
+	}
+	want := caps.Cap{
+		Type:     caps.TypeString,
+		Index:    caps.CursorAddress,
+		Name:     "cup",
+		LongName: "cursor_address",
+		Code:     "cm",
+		GoName:   "CursorAddress",
+	}
+	if c := caps.StringByName["cup"]; c != want {
+		t.Errorf("StringByName[cup] = %+v, want %+v", c, want)
+	}
+	if c := caps.BoolCaps[caps.AutoRightMargin]; c.Name != "am" || c.GoName != "AutoRightMargin" {
+		t.Errorf("BoolCaps[AutoRightMargin] = %+v", c)
+	}
+	if c := caps.NumberByName["colors"]; c.Index != caps.MaxColors || c.Type != caps.TypeNumber {
+		t.Errorf("NumberByName[colors] = %+v", c)
+	}
+}
+
+func TestGotoXY(t *testing.T) {
+	var buf [32]byte
+	for _, name := range []string{"xterm", "vt100", "linux"} {
+		ti, err := Load(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, rc := range [][2]int{{0, 0}, {23, 79}, {-1, 5}, {9999, 9999}} {
+			if got, want := string(ti.GotoXY(&buf, rc[0], rc[1])), ti.Goto(rc[0], rc[1]); got != want {
+				t.Errorf("%s: GotoXY(%d, %d) = %q, want %q", name, rc[0], rc[1], got, want)
+			}
+		}
+	}
+	ti, _ := Load("xterm")
+	if n := testing.AllocsPerRun(100, func() { ti.GotoXY(&buf, 10, 20) }); n != 0 && !raceEnabled {
+		t.Errorf("GotoXY allocates %v times", n)
+	}
+}
+
+func BenchmarkGotoXY(b *testing.B) {
+	ti, err := Load("xterm")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var buf [32]byte
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ti.GotoXY(&buf, i%50, i%200)
+	}
+}
+
+func BenchmarkGetString(b *testing.B) {
+	ti, err := Load("xterm")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ti.GetString("cup")
+	}
+}
+
+func TestLoadWithFallback(t *testing.T) {
+	want := &Terminfo{Names: []string{"terminfo-test-builtin", "test entry"}}
+	RegisterBuiltin(want)
+	ti, err := LoadWithFallback("terminfo-test-builtin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Names[0] != want.Names[0] {
+		t.Errorf("got %v, want the registered builtin entry", ti.Names)
+	}
+	if _, err = LoadWithFallback("terminfo-test-missing"); err == nil {
+		t.Error("expected an error for an unknown entry")
+	}
+}
+
+func TestLoadWithFallbacks(t *testing.T) {
+	got := degrade("tmux-256color-direct")
+	want := []string{"screen-256color-direct", "tmux-256color", "screen-256color", "tmux", "screen"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("degrade = %q, want %q", got, want)
+	}
+	ti, err := LoadWithFallbacks("rxvt-unicode-256color-missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Names[0] != "rxvt-unicode-256color" {
+		t.Errorf("got %v, want rxvt-unicode-256color", ti.Names)
+	}
+	ti, err = LoadWithFallbacks("terminfo-test-missing", "terminfo-test-missing2", "vt100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Names[0] != "vt100" {
+		t.Errorf("got %v, want vt100", ti.Names)
+	}
+	if _, err = LoadWithFallbacks("terminfo-test-missing", "terminfo-test-missing2"); err == nil {
+		t.Error("expected an error when no fallback exists")
+	}
+}
+
+func TestLoadComposite(t *testing.T) {
+	ti, err := Load("tmux.rxvt-unicode-256color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmux, err := Load("tmux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rxvt, err := Load("rxvt-unicode-256color")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Names[0] != "tmux.rxvt-unicode-256color" {
+		t.Errorf("Names = %q", ti.Names)
+	}
+	if ti.Strings[caps.ClearScreen] != tmux.Strings[caps.ClearScreen] {
+		t.Error("clear was not taken from tmux")
+	}
+	if ti.Strings[caps.KeyF1] != rxvt.Strings[caps.KeyF1] {
+		t.Error("kf1 was not taken from rxvt-unicode-256color")
+	}
+	if ti.Numbers[caps.MaxColors] != 256 {
+		t.Errorf("colors = %d, want 256", ti.Numbers[caps.MaxColors])
+	}
+	if _, err = Load("tmux.no-such-terminal"); err == nil {
+		t.Error("expected an error for a missing inner entry")
+	}
+	if _, err = Load("vt100.xterm"); err == nil {
+		t.Error("composed an entry for an outer entry that is not a multiplexer")
+	}
+}
+
+func TestComposeUnnamed(t *testing.T) {
+	outer, inner := &Terminfo{}, &Terminfo{}
+	outer.Numbers[caps.MaxColors] = 8
+	outer.Strings[caps.SetAForeground] = "\x1b[3%p1%dm"
+	inner.Numbers[caps.MaxColors] = 256
+	ti := Compose("screen.custom", outer, inner)
+	if want := []string{"screen.custom", "screen running in custom"}; !reflect.DeepEqual(ti.Names, want) {
+		t.Errorf("Names = %q, want %q", ti.Names, want)
+	}
+	if ti.Strings[caps.SetAForeground] != outer.Strings[caps.SetAForeground] {
+		t.Error("setaf was replaced by the empty one of the inner entry")
+	}
+}
+
+func TestPutsPadding(t *testing.T) {
+	ti := &Terminfo{}
+	ti.Numbers[caps.PaddingBaudRate] = 1200
+	ti.Strings[caps.PadChar] = "*"
+	// The expected counts are ms * baud / 9000 rounded to the nearest, as
+	// ncurses' delay_output but without truncating the delay to whole
+	// milliseconds.
+	tests := []struct {
+		s     string
+		lines int
+		baud  int
+		want  string
+	}{
+		{"a$<5>", 1, 300, "a"},
+		{"a$<5>", 1, 9600, "a*****"},
+		{"a$<5>", 1, 38400, "a" + strings.Repeat("*", 21)},
+		{"a$<0.5>", 1, 38400, "a**"},
+		{"a$<1.5>", 1, 38400, "a******"},
+		{"a$<10*>", 3, 1200, "a****"},
+		{"a$<10*>", 3, 300, "a"},
+		{"a$<10*>", 0, 1200, "a*"},
+		{"a$<2.5*/>", 3, 300, "a"},
+		{"a$<2.5*/>", 3, 9600, "a********"},
+		{"a$<100/>", 1, 300, "a***"},
+		{"a$<x>b", 1, 9600, "a$<x>b"},
+		{"a$<3", 1, 9600, "a$<3"},
+		{"$<", 1, 9600, "$<"},
+		{"a$<99999999999999999999999/>", 1, math.MaxInt64, "a" + strings.Repeat("*", maxPadding)},
+	}
+	for _, tt := range tests {
+		b := new(bytes.Buffer)
+		n, err := ti.Puts(b, tt.s, tt.lines, tt.baud)
+		if err != nil || n != b.Len() {
+			t.Errorf("Puts(%q) = %d, %v; wrote %d bytes", tt.s, n, err, b.Len())
+		}
+		if b.String() != tt.want {
+			t.Errorf("Puts(%q, %d, %d) = %q, want %q", tt.s, tt.lines, tt.baud, b.String(), tt.want)
+		}
+	}
+}
+
+func TestParseDelays(t *testing.T) {
+	s, ds := ParseDelays("\x1b[H$<5>\x1b[J$<2.5*/>$<x>")
+	if s != "\x1b[H\x1b[J$<x>" {
+		t.Errorf("stripped = %q", s)
+	}
+	want := []Delay{
+		{Offset: 3, Duration: 5 * time.Millisecond},
+		{Offset: 6, Duration: 2500 * time.Microsecond, Proportional: true, Mandatory: true},
+	}
+	if len(ds) != len(want) {
+		t.Fatalf("got %+v, want %+v", ds, want)
+	}
+	for i := range want {
+		if ds[i] != want[i] {
+			t.Errorf("delay %d = %+v, want %+v", i, ds[i], want[i])
+		}
+	}
+	if d := ds[1].For(4); d != 10*time.Millisecond {
+		t.Errorf("For(4) = %v, want 10ms", d)
+	}
+	if s, ds := ParseDelays("plain"); s != "plain" || ds != nil {
+		t.Errorf("ParseDelays(plain) = %q, %v", s, ds)
+	}
+}
+
+func TestPutsOpts(t *testing.T) {
+	ti := &Terminfo{}
+	ti.Numbers[caps.PaddingBaudRate] = 1200
+	var slept time.Duration
+	sleep = func(d time.Duration) { slept += d }
+	defer func() { sleep = time.Sleep }()
+
+	b := new(bytes.Buffer)
+	opts := PadOptions{Strategy: PadSleep, Baud: 9600, Lines: 2}
+	if _, err := ti.PutsOpts(b, "a$<2.5*>b$<1/>", opts); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "ab" || slept != 6*time.Millisecond {
+		t.Errorf("got %q and slept %v, want \"ab\" and 6ms", b.String(), slept)
+	}
+
+	b.Reset()
+	opts.Strategy = PadNone
+	ti.PutsOpts(b, "a$<100/>", opts)
+	if b.String() != "a" {
+		t.Errorf("PadNone emitted %q", b.String())
+	}
+
+	if n, err := ti.PutsOpts(errWriter{}, "a$<5/>", opts); n != 0 || err != errWrite {
+		t.Errorf("got %d, %v, want 0 and the write error", n, err)
+	}
+}
+
+var errWrite = errors.New("write failed")
+
+// errWriter fails all writes.
+type errWriter struct{}
+
+func (errWriter) Write(b []byte) (int, error) {
+	return 0, errWrite
+}
+
+func TestLoaderFS(t *testing.T) {
+	b, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		t.Skip(err)
+	}
+	fsys := fstest.MapFS{
+		"db/x/xterm":              {Data: b},
+		"db/78/xterm-hexdir-only": {Data: b},
+	}
+	l := NewLoader(fsys, "missing", "db")
+	ti, err := l.Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Strings[caps.CursorAddress] == "" {
+		t.Error("cup is missing")
+	}
+	if _, err = l.Load("xterm-hexdir-only"); err != nil {
+		t.Errorf("darwin layout: %v", err)
+	}
+	if _, err = l.Load("vt100"); err == nil {
+		t.Error("loaded an entry missing from the file system")
+	}
+	names, err := l.ListEntries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"xterm", "xterm-hexdir-only"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListEntries = %q, want %q", names, want)
+	}
+}
+
+func TestTerminfoFile(t *testing.T) {
+	b, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		t.Skip(err)
+	}
+	f := filepath.Join(t.TempDir(), "entry")
+	if err = ioutil.WriteFile(f, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("TERMINFO", os.Getenv("TERMINFO"))
+	os.Setenv("TERMINFO", f)
+	l := &Loader{cache: NewCache(0)}
+	if _, err = l.Load("xterm"); err != nil {
+		t.Errorf("entry from $TERMINFO file: %v", err)
+	}
+	if _, err = l.Load("vt100"); err != nil {
+		t.Errorf("entry missing from $TERMINFO file: %v", err)
+	}
+}
+
+func TestLoaderLayouts(t *testing.T) {
+	entry := func(names ...string) *fstest.MapFile {
+		ti, err := NewBuilder(names...).SetNumber(caps.Columns, len(names[0])).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := EncodeBytes(ti)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &fstest.MapFile{Data: b}
+	}
+	fsys := fstest.MapFS{
+		"letter/t/test": entry("test"),
+		"hex/74/test":   entry("test"),
+		"copy/m/myterm": entry("test"),
+		// On a case-insensitive file system, E/Eterm is e/eterm.
+		"ci/E/Eterm":      entry("eterm"),
+		"ci/45/Eterm":     entry("Eterm", "Eterm-color"),
+		"ci/65/eterm":     entry("eterm"),
+		"hashed.cdb":      {Data: []byte("NBCDB\n")},
+		"dir/terminfo.db": {Data: make([]byte, 4096)},
+	}
+	for _, tt := range []struct {
+		dir, name, want string
+	}{
+		{"letter", "test", "test"},
+		{"hex", "test", "test"},
+		{"copy", "myterm", "test"},
+		{"ci", "Eterm", "Eterm"},
+		{"ci", "eterm", "eterm"},
+	} {
+		ti, err := NewLoader(fsys, tt.dir).Load(tt.name)
+		if err != nil {
+			t.Errorf("%s/%s: %v", tt.dir, tt.name, err)
+			continue
+		}
+		if ti.Names[0] != tt.want {
+			t.Errorf("%s/%s: loaded %s", tt.dir, tt.name, ti.Names[0])
+		}
+	}
+	for _, dir := range []string{"hashed", "hashed.cdb", "dir/terminfo"} {
+		if _, err := NewLoader(fsys, dir).Load("test"); err != ErrHashedDB {
+			t.Errorf("%s: got error %v, want ErrHashedDB", dir, err)
+		}
+	}
+}
+
+// testdata/terminfo.db was written with the ndbm interface of Berkeley DB
+// 5.3 the way ncurses writes it, and holds vt100 and a "big" entry that
+// spans two overflow pages.
+func TestLoadHashedDB(t *testing.T) {
+	for _, dir := range []string{"testdata/terminfo", "testdata/terminfo.db"} {
+		for _, name := range []string{"vt100", "vt100-am", "big"} {
+			ti, err := NewLoader(nil, dir).Load(name)
+			if err != nil {
+				t.Errorf("%s/%s: %v", dir, name, err)
+				continue
+			}
+			if ti.Names[0] != strings.SplitN(name, "-", 2)[0] {
+				t.Errorf("%s/%s: loaded %s", dir, name, ti.Names[0])
+			}
+		}
+		if _, err := NewLoader(nil, dir).Load("xterm"); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("%s/xterm: got error %v, want fs.ErrNotExist", dir, err)
+		}
+	}
+	ti, err := NewLoader(nil, "testdata/terminfo").Load("big")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := ti.Strings[caps.KeyF0]; len(s) != 1002 {
+		t.Errorf("big: kf0 has %d bytes, want 1002", len(s))
+	}
+	b, err := ioutil.ReadFile("testdata/terminfo.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []int{100, 4096, 8192, 12288} {
+		fsys := fstest.MapFS{"terminfo.db": {Data: b[:n]}}
+		if _, err := NewLoader(fsys, "terminfo.db").Load("big"); err == nil {
+			t.Errorf("truncated to %d bytes: no error", n)
+		}
+	}
+}
+
+func TestWalkDB(t *testing.T) {
+	b, err := ioutil.ReadFile("/lib/terminfo/x/xterm")
+	if err != nil {
+		t.Skip(err)
+	}
+	v, err := ioutil.ReadFile("/lib/terminfo/v/vt100")
+	if err != nil {
+		t.Skip(err)
+	}
+	fsys := fstest.MapFS{
+		"db/x/xterm":        {Data: b},
+		"db/v/vt100":        {Data: v},
+		"db/v/vt100-am":     {Data: v},
+		"db/v/README":       {Data: []byte("not an entry")},
+		"db/top-level-file": {Data: v},
+	}
+	l := NewLoader(fsys)
+	var visited []string
+	err = l.WalkDB("db", func(names []string, load func() (*Terminfo, error)) error {
+		visited = append(visited, names[0])
+		if names[0] != "xterm" {
+			return nil
+		}
+		ti, err := load()
+		if err != nil {
+			return err
+		}
+		if ti.Strings[caps.CursorAddress] == "" || ti.Origins[0].Name != "db/x/xterm" {
+			t.Errorf("loaded %q from %v", ti.Names, ti.Origins)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"vt100", "xterm"}; !reflect.DeepEqual(visited, want) {
+		t.Errorf("visited %q, want %q", visited, want)
+	}
+	stop := errors.New("stop")
+	if err := l.WalkDB("db", func([]string, func() (*Terminfo, error)) error { return stop }); err != stop {
+		t.Errorf("WalkDB returned %v, want the error of the WalkFunc", err)
+	}
+}
+
+func TestSearchPath(t *testing.T) {
+	for _, k := range []string{"TERMINFO", "HOME", "TERMINFO_DIRS"} {
+		defer os.Setenv(k, os.Getenv(k))
+	}
+	os.Setenv("TERMINFO", "/a")
+	os.Setenv("HOME", "/home/u")
+	os.Setenv("TERMINFO_DIRS", "/b::/a")
+	dirs := SearchPath()
+	want := []string{"/a", "/home/u/.terminfo", "/b", systemDir}
+	for _, dir := range systemDirs {
+		if dir != systemDir {
+			want = append(want, dir)
+		}
+	}
+	if !reflect.DeepEqual(dirs, want) {
+		t.Errorf("SearchPath = %q, want %q", dirs, want)
+	}
+
+	// An existing $TERMINFO directory lacking the entry does not end the search.
+	os.Setenv("TERMINFO", t.TempDir())
+	os.Setenv("TERMINFO_DIRS", "")
+	l := &Loader{cache: NewCache(0)}
+	if _, err := l.Load("xterm"); err != nil {
+		t.Errorf("entry outside of $TERMINFO: %v", err)
+	}
+}
+
+func TestParmBounds(t *testing.T) {
+	ti, err := Load("vt100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := ti.Parm(-1); s != "" {
+		t.Errorf("Parm(-1) = %q", s)
+	}
+	if _, err = ti.ParmErr(caps.StringCount); err != ErrCapIndex {
+		t.Errorf("ParmErr(StringCount) error = %v, want ErrCapIndex", err)
+	}
+	if _, err = ti.ParmErr(caps.SetAForeground, 1); err != ErrCapAbsent {
+		t.Errorf("ParmErr(setaf) error = %v, want ErrCapAbsent", err)
+	}
+	if s := ti.MustParm(caps.CursorAddress, 0, 0); s != ti.Goto(0, 0) {
+		t.Errorf("MustParm(cup) = %q", s)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParm did not panic for an absent capability")
+		}
+	}()
+	ti.MustParm(caps.SetAForeground, 1)
+}
+
+func TestFlowControl(t *testing.T) {
+	ti := &Terminfo{}
+	ti.Numbers[caps.PaddingBaudRate] = 1200
+	ti.Strings[caps.XonCharacter] = "\x01"
+	fc := ti.FlowControl()
+	if fc.XonXoff || fc.Required || fc.Xon != 1 || fc.Xoff != DefaultXoff || fc.PaddingBaud != 1200 {
+		t.Errorf("FlowControl = %+v", fc)
+	}
+	ti.Bools[caps.NeedsXonXoff] = true
+	b := new(bytes.Buffer)
+	ti.Puts(b, "a$<10>b$<1/>", 1, 9600)
+	if b.String() == "ab\x00" {
+		t.Errorf("nxon dropped padding: %q", b.String())
+	}
+	ti.Bools[caps.XonXoff] = true
+	b.Reset()
+	ti.Puts(b, "a$<10>b$<1/>", 1, 9600)
+	if b.String() != "ab\x00" {
+		t.Errorf("xon kept padding: %q", b.String())
+	}
+}
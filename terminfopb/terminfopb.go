@@ -0,0 +1,330 @@
+// Package terminfopb encodes terminfo entries in the protocol buffers
+// format described by terminfo.proto, so that tools written in other
+// languages can consume entries resolved by the terminfo package without
+// reimplementing the compiled terminfo format.
+//
+// The package implements the wire format directly and does not depend on
+// any protocol buffers runtime.
+package terminfopb
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/nhooyr/terminfo"
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Kinds of capabilities, see the Kind enum in terminfo.proto.
+const (
+	KindBoolean = 1
+	KindNumber  = 2
+	KindString  = 3
+)
+
+// Field numbers of the Entry message.
+const (
+	entryNames        = 1
+	entryCapabilities = 2
+)
+
+// Field numbers of the Capability message.
+const (
+	capName        = 1
+	capKind        = 2
+	capExtended    = 3
+	capBoolValue   = 4
+	capNumberValue = 5
+	capStringValue = 6
+	capCancelled   = 7
+)
+
+// Wire types.
+const (
+	wireVarint = 0
+	wireBytes  = 2
+)
+
+// ErrBadMessage is returned by Unmarshal when the message is malformed.
+var ErrBadMessage = errors.New("terminfopb: bad message")
+
+// cancelled is the value of cancelled capabilities for Marshal.
+type cancelled struct{}
+
+// Marshal encodes ti as an Entry message. Standard numbers and strings that
+// are present with the value 0 or "" or that are cancelled, see
+// Terminfo.CapStates, are encoded too.
+func Marshal(ti *terminfo.Terminfo) []byte {
+	var b []byte
+	for _, n := range ti.Names {
+		b = appendBytes(b, entryNames, []byte(n))
+	}
+	var c []byte
+	appendCap := func(name string, kind int, ext bool, v interface{}) {
+		c = appendBytes(c[:0], capName, []byte(name))
+		c = appendVarint(c, capKind, uint64(kind))
+		if ext {
+			c = appendVarint(c, capExtended, 1)
+		}
+		switch v := v.(type) {
+		case bool:
+			if v {
+				c = appendVarint(c, capBoolValue, 1)
+			}
+		case int16:
+			if v != 0 {
+				c = appendVarint(c, capNumberValue, uint64(int64(v)))
+			}
+		case string:
+			if v != "" {
+				c = appendBytes(c, capStringValue, []byte(v))
+			}
+		case cancelled:
+			c = appendVarint(c, capCancelled, 1)
+		}
+		b = appendBytes(b, entryCapabilities, c)
+	}
+	for _, i := range sortedIndexes(caps.BoolNames[:]) {
+		if ti.Bools[i] {
+			appendCap(caps.BoolNames[i], KindBoolean, false, true)
+		}
+	}
+	for _, i := range sortedIndexes(caps.NumberNames[:]) {
+		switch ti.Number(i) {
+		case terminfo.NumAbsent:
+		case terminfo.NumCancelled:
+			appendCap(caps.NumberNames[i], KindNumber, false, cancelled{})
+		default:
+			appendCap(caps.NumberNames[i], KindNumber, false, ti.Numbers[i])
+		}
+	}
+	for _, i := range sortedIndexes(caps.StringNames[:]) {
+		n := caps.StringNames[i]
+		switch {
+		case ti.Strings[i] != "" || ti.CapStates[n] == terminfo.CapEmpty:
+			appendCap(n, KindString, false, ti.Strings[i])
+		case ti.CapStates[n] == terminfo.CapCancelled:
+			appendCap(n, KindString, false, cancelled{})
+		}
+	}
+	for _, k := range sortedKeys(ti.ExtBools) {
+		appendCap(k, KindBoolean, true, ti.ExtBools[k])
+	}
+	for _, k := range sortedKeys(ti.ExtNumbers) {
+		appendCap(k, KindNumber, true, ti.ExtNumbers[k])
+	}
+	for _, k := range sortedKeys(ti.ExtStrings) {
+		appendCap(k, KindString, true, ti.ExtStrings[k])
+	}
+	return b
+}
+
+// Unmarshal decodes an Entry message. Unknown fields are skipped and
+// unknown standard capabilities are treated as extended ones.
+func Unmarshal(b []byte) (*terminfo.Terminfo, error) {
+	ti := &terminfo.Terminfo{
+		ExtBools:   make(map[string]bool),
+		ExtNumbers: make(map[string]int16),
+		ExtStrings: make(map[string]string),
+	}
+	err := fields(b, func(num, wt int, v uint64, p []byte) error {
+		switch {
+		case num == entryNames && wt == wireBytes:
+			ti.Names = append(ti.Names, string(p))
+		case num == entryCapabilities && wt == wireBytes:
+			return unmarshalCap(ti, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ti, nil
+}
+
+// unmarshalCap decodes a Capability message into ti.
+func unmarshalCap(ti *terminfo.Terminfo, b []byte) error {
+	var (
+		name string
+		kind int
+		ext  bool
+		bv   bool
+		nv   int16
+		sv   string
+		cv   bool
+	)
+	err := fields(b, func(num, wt int, v uint64, p []byte) error {
+		switch num {
+		case capName:
+			name = string(p)
+		case capKind:
+			kind = int(v)
+		case capExtended:
+			ext = v != 0
+		case capBoolValue:
+			bv = v != 0
+		case capNumberValue:
+			nv = int16(int32(v))
+		case capStringValue:
+			sv = string(p)
+		case capCancelled:
+			cv = v != 0
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case KindBoolean:
+		if i, ok := caps.LookupBool(name); ok && !ext {
+			ti.Bools[i] = bv
+		} else {
+			ti.ExtBools[name] = bv
+		}
+	case KindNumber:
+		if i, ok := caps.LookupNumber(name); ok && !ext {
+			ti.Numbers[i] = nv
+			setState(ti, name, nv == 0, cv)
+		} else {
+			ti.ExtNumbers[name] = nv
+		}
+	case KindString:
+		if i, ok := caps.LookupString(name); ok && !ext {
+			ti.Strings[i] = sv
+			setState(ti, name, sv == "", cv)
+		} else {
+			ti.ExtStrings[name] = sv
+		}
+	}
+	return nil
+}
+
+// setState records the state of the standard capability with the name
+// whose value is zero.
+func setState(ti *terminfo.Terminfo, name string, zero, cancelled bool) {
+	if !zero {
+		return
+	}
+	if ti.CapStates == nil {
+		ti.CapStates = make(map[string]terminfo.CapState)
+	}
+	if cancelled {
+		ti.CapStates[name] = terminfo.CapCancelled
+	} else {
+		ti.CapStates[name] = terminfo.CapEmpty
+	}
+}
+
+// fields calls f for every field in the message b. v holds the value of
+// varint fields and p the payload of length-delimited fields.
+func fields(b []byte, f func(num, wt int, v uint64, p []byte) error) error {
+	for len(b) > 0 {
+		key, n := varint(b)
+		if n == 0 {
+			return ErrBadMessage
+		}
+		b = b[n:]
+		num, wt := int(key>>3), int(key&7)
+		var v uint64
+		var p []byte
+		switch wt {
+		case wireVarint:
+			if v, n = varint(b); n == 0 {
+				return ErrBadMessage
+			}
+			b = b[n:]
+		case wireBytes:
+			l, n := varint(b)
+			if n == 0 || uint64(len(b)-n) < l {
+				return ErrBadMessage
+			}
+			p, b = b[n:n+int(l)], b[n+int(l):]
+		case 1: // 64-bit
+			if len(b) < 8 {
+				return ErrBadMessage
+			}
+			b = b[8:]
+		case 5: // 32-bit
+			if len(b) < 4 {
+				return ErrBadMessage
+			}
+			b = b[4:]
+		default:
+			return ErrBadMessage
+		}
+		if err := f(num, wt, v, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// varint decodes a varint from b and returns it and the number of bytes
+// read, which is 0 if b does not start with a valid varint.
+func varint(b []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < len(b) && i < 10; i++ {
+		v |= uint64(b[i]&0x7f) << (7 * uint(i))
+		if b[i] < 0x80 {
+			return v, i + 1
+		}
+	}
+	return 0, 0
+}
+
+// appendVarint appends the varint field num with value v to b.
+func appendVarint(b []byte, num int, v uint64) []byte {
+	b = appendUvarint(b, uint64(num)<<3|wireVarint)
+	return appendUvarint(b, v)
+}
+
+// appendBytes appends the length-delimited field num with payload p to b.
+func appendBytes(b []byte, num int, p []byte) []byte {
+	b = appendUvarint(b, uint64(num)<<3|wireBytes)
+	b = appendUvarint(b, uint64(len(p)))
+	return append(b, p...)
+}
+
+// appendUvarint appends v to b in the varint encoding.
+func appendUvarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+// sortedIndexes returns the indexes of names sorted by name.
+func sortedIndexes(names []string) []int {
+	idx := make([]int, len(names))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool {
+		return names[idx[i]] < names[idx[j]]
+	})
+	return idx
+}
+
+// sortedKeys returns the keys of m, which must be one of the extended
+// capability maps, in sorted order.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]bool:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]int16:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]string:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,52 @@
+package terminfopb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nhooyr/terminfo"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, name := range []string{"xterm", "rxvt-unicode-256color", "vt100"} {
+		ti, err := terminfo.Load(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Unmarshal(Marshal(ti))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		want := *ti
+		want.Origins = nil
+		if want.ExtBools == nil {
+			// Entries without extended capabilities have nil maps.
+			want.ExtBools = map[string]bool{}
+			want.ExtNumbers = map[string]int16{}
+			want.ExtStrings = map[string]string{}
+		}
+		if !reflect.DeepEqual(got, &want) {
+			t.Errorf("%s: round trip mismatch", name)
+		}
+	}
+}
+
+func TestRoundTripStates(t *testing.T) {
+	ti := &terminfo.Terminfo{
+		Names:     []string{"states"},
+		CapStates: map[string]terminfo.CapState{"cup": terminfo.CapCancelled, "bel": terminfo.CapEmpty, "cols": terminfo.CapEmpty},
+	}
+	got, err := Unmarshal(Marshal(ti))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.CapStates, ti.CapStates) {
+		t.Errorf("CapStates = %v, want %v", got.CapStates, ti.CapStates)
+	}
+}
+
+func TestUnmarshalBad(t *testing.T) {
+	if _, err := Unmarshal([]byte{0x0a, 0x05, 'x'}); err != ErrBadMessage {
+		t.Errorf("got %v, want ErrBadMessage", err)
+	}
+}
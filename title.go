@@ -0,0 +1,56 @@
+package terminfo
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// ErrNoTitle is returned by SetTitle for terminals without a title or
+// status line.
+var ErrNoTitle = errors.New("terminfo: terminal cannot set its title")
+
+// xtermTerms are the prefixes of the names of terminals known to support
+// xterm's title (OSC 2) and bracketed paste although their entries lack XT.
+var xtermTerms = []string{
+	"xterm", "rxvt", "urxvt", "screen", "tmux", "alacritty", "kitty", "foot",
+	"wezterm", "gnome", "konsole", "putty", "iterm", "vte", "st-", "contour",
+}
+
+// SetTitle writes the string setting the title of the terminal to w. The
+// status line is used if the terminal has one (hs) and can write to it
+// (tsl and fsl), as xterm's entries with a status line describe the title
+// that way. Otherwise xterm's OSC 2 is used for terminals with the XT
+// extended capability or known to support it by name. ErrNoTitle is
+// returned for other terminals. Control characters are removed from title.
+func (ti *Terminfo) SetTitle(w io.Writer, title string) error {
+	title = stripControls(title)
+	tsl, fsl := ti.Strings[caps.ToStatusLine], ti.Strings[caps.FromStatusLine]
+	if ti.Bools[caps.HasStatusLine] && tsl != "" && fsl != "" {
+		_, err := ti.Puts(w, Parm(tsl, 0)+title+fsl, 1, 0)
+		return err
+	}
+	if !ti.xtermLike() {
+		return ErrNoTitle
+	}
+	_, err := io.WriteString(w, OSC(2, title))
+	return err
+}
+
+// xtermLike reports whether the terminal has the XT extended capability or
+// a name starting with one of xtermTerms.
+func (ti *Terminfo) xtermLike() bool {
+	if ti.ExtBool("XT") {
+		return true
+	}
+	for _, name := range ti.Names {
+		for _, p := range xtermTerms {
+			if strings.HasPrefix(name, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package terminfo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestSetTitle(t *testing.T) {
+	var b bytes.Buffer
+	ti := &Terminfo{Names: []string{"dumb"}}
+	if err := ti.SetTitle(&b, "x"); err != ErrNoTitle {
+		t.Errorf("got %v, want ErrNoTitle", err)
+	}
+	ti.Set("XT", Capability{Kind: CapBool, Present: true, Bool: true})
+	ti.SetTitle(&b, "a\x07b")
+	if want := "\x1b]2;ab\x1b\\"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+
+	b.Reset()
+	ti.Bools[caps.HasStatusLine] = true
+	ti.Strings[caps.ToStatusLine] = "\x1b]2;"
+	ti.Strings[caps.FromStatusLine] = "\x07"
+	ti.SetTitle(&b, "title")
+	if want := "\x1b]2;title\x07"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+
+	b.Reset()
+	ti = &Terminfo{Names: []string{"tmux-256color"}}
+	if err := ti.SetTitle(&b, "t"); err != nil || b.Len() == 0 {
+		t.Errorf("tmux title: %q, %v", b.String(), err)
+	}
+}
@@ -0,0 +1,162 @@
+package terminfo
+
+import (
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// TokenKind is the kind of a Token.
+type TokenKind int
+
+// These are the kinds of tokens.
+const (
+	// TokenText is printable text.
+	TokenText TokenKind = iota
+	// TokenControl is a single C0 control character, such as a newline,
+	// or DEL.
+	TokenControl
+	// TokenSequence is an escape sequence, such as a control sequence
+	// (CSI) or a control string (OSC, DCS).
+	TokenSequence
+)
+
+// Token is a piece of terminal output.
+type Token struct {
+	Kind TokenKind
+	Data string
+	// Cap is the short name of the capability of the entry whose value is
+	// exactly the sequence or control character, if there is one.
+	// Parameterized capabilities are not matched.
+	Cap string
+}
+
+// Tokenizer splits terminal output into text, control characters and escape
+// sequences, following the syntax of ECMA-48.
+type Tokenizer struct {
+	seqs map[string]string
+	buf  []byte
+}
+
+// NewTokenizer returns a Tokenizer recognizing the capabilities of ti.
+func (ti *Terminfo) NewTokenizer() *Tokenizer {
+	t := &Tokenizer{seqs: make(map[string]string)}
+	add := func(name, s string) {
+		s = stripDelays(s)
+		if _, ok := t.seqs[s]; !ok && s != "" && !strings.Contains(s, "%") {
+			t.seqs[s] = name
+		}
+	}
+	for i, s := range ti.Strings {
+		// Input capabilities would mislabel output, such as kcub1=^H.
+		if !strings.HasPrefix(caps.StringLongNames[i], "key_") {
+			add(caps.StringNames[i], s)
+		}
+	}
+	for _, name := range sortedKeys(ti.ExtStrings) {
+		if name[0] != 'k' {
+			add(name, ti.ExtStrings[name])
+		}
+	}
+	return t
+}
+
+// stripDelays removes the padding indications of s.
+func stripDelays(s string) string {
+	var stripped string
+	for {
+		i := strings.Index(s, "$<")
+		if i == -1 {
+			return stripped + s
+		}
+		end := strings.IndexByte(s[i:], '>')
+		if end == -1 {
+			return stripped + s
+		}
+		stripped += s[:i]
+		s = s[i+end+1:]
+	}
+}
+
+// Feed returns the tokens in the buffered output and b. An incomplete
+// escape sequence at the end is kept until more output arrives or Flush is
+// called.
+func (t *Tokenizer) Feed(b []byte) []Token {
+	t.buf = append(t.buf, b...)
+	return t.tokens(false)
+}
+
+// Flush returns the tokens in the buffered output, including an incomplete
+// escape sequence.
+func (t *Tokenizer) Flush() []Token {
+	return t.tokens(true)
+}
+
+// tokens splits the buffer into tokens.
+func (t *Tokenizer) tokens(flush bool) []Token {
+	var toks []Token
+	b := t.buf
+	for len(b) > 0 {
+		var tok Token
+		n := 1
+		switch c := b[0]; {
+		case c == '\x1b':
+			var ok bool
+			n, ok = escapeLen(b)
+			if !ok && !flush {
+				t.buf = append(t.buf[:0], b...)
+				return toks
+			}
+			tok = Token{Kind: TokenSequence, Data: string(b[:n])}
+		case c < ' ' || c == 0x7f:
+			tok = Token{Kind: TokenControl, Data: string(b[:1])}
+		default:
+			for n < len(b) && b[n] >= ' ' && b[n] != 0x7f {
+				n++
+			}
+			tok = Token{Kind: TokenText, Data: string(b[:n])}
+		}
+		if tok.Kind != TokenText {
+			tok.Cap = t.seqs[tok.Data]
+		}
+		toks = append(toks, tok)
+		b = b[n:]
+	}
+	t.buf = t.buf[:0]
+	return toks
+}
+
+// escapeLen returns the length of the escape sequence at the start of b,
+// which starts with ESC. ok is false if the sequence is incomplete, in which
+// case n is len(b).
+func escapeLen(b []byte) (n int, ok bool) {
+	if len(b) < 2 {
+		return len(b), false
+	}
+	switch b[1] {
+	case '[':
+		for i := 2; i < len(b); i++ {
+			if b[i] >= 0x40 && b[i] <= 0x7e {
+				return i + 1, true
+			}
+		}
+		return len(b), false
+	case ']', 'P', '_', '^', 'X':
+		for i := 2; i < len(b); i++ {
+			if b[i] == '\a' {
+				return i + 1, true
+			}
+			if b[i] == '\x1b' && i+1 < len(b) && b[i+1] == '\\' {
+				return i + 2, true
+			}
+		}
+		return len(b), false
+	}
+	// Intermediate bytes followed by a final byte, as in ESC ( B.
+	for i := 1; i < len(b); i++ {
+		if b[i] < 0x20 || b[i] > 0x2f {
+			return i + 1, true
+		}
+	}
+	return len(b), false
+}
@@ -0,0 +1,214 @@
+package terminfo
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// WriteUnibiDump writes ti to w in a textual dump form modeled on the output
+// of unibilium's unibi-dump tool, for exchanging entries and test fixtures
+// with unibilium based projects:
+//
+//	Name: xterm terminal emulator (X Window System)
+//	Aliases: xterm, xterm-debian
+//	Booleans:
+//	    auto_right_margin(am): true
+//	Numerics:
+//	    columns(cols): 80
+//	Strings:
+//	    bell(bel): "\a"
+//	Extended booleans:
+//	    AX: true
+//	Extended numerics:
+//	Extended strings:
+//	    Cr: "\033]112\a"
+//
+// Like in unibilium, the name is the last of ti.Names, the description,
+// and the aliases are the others. Only present capabilities are written.
+// Strings are quoted with C escapes.
+func (ti *Terminfo) WriteUnibiDump(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	var name string
+	var aliases []string
+	if n := len(ti.Names); n > 0 {
+		name, aliases = ti.Names[n-1], ti.Names[:n-1]
+	}
+	bw.WriteString("Name: " + name + "\n")
+	bw.WriteString("Aliases: " + strings.Join(aliases, ", ") + "\n")
+	bw.WriteString("Booleans:\n")
+	for i, v := range ti.Bools {
+		if v {
+			bw.WriteString("    " + caps.BoolLongNames[i] + "(" + caps.BoolNames[i] + "): true\n")
+		}
+	}
+	bw.WriteString("Numerics:\n")
+	for i, v := range ti.Numbers {
+		if v != 0 {
+			bw.WriteString("    " + caps.NumberLongNames[i] + "(" + caps.NumberNames[i] + "): " + strconv.Itoa(int(v)) + "\n")
+		}
+	}
+	bw.WriteString("Strings:\n")
+	for i, v := range ti.Strings {
+		if v != "" {
+			bw.WriteString("    " + caps.StringLongNames[i] + "(" + caps.StringNames[i] + "): " + quoteC(v) + "\n")
+		}
+	}
+	bw.WriteString("Extended booleans:\n")
+	for _, k := range sortedKeys(ti.ExtBools) {
+		if ti.ExtBools[k] {
+			bw.WriteString("    " + k + ": true\n")
+		}
+	}
+	bw.WriteString("Extended numerics:\n")
+	for _, k := range sortedKeys(ti.ExtNumbers) {
+		bw.WriteString("    " + k + ": " + strconv.Itoa(int(ti.ExtNumbers[k])) + "\n")
+	}
+	bw.WriteString("Extended strings:\n")
+	for _, k := range sortedKeys(ti.ExtStrings) {
+		bw.WriteString("    " + k + ": " + quoteC(ti.ExtStrings[k]) + "\n")
+	}
+	return bw.Flush()
+}
+
+// quoteC quotes s as a C string literal. Bytes without a short escape are
+// written in octal.
+func quoteC(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\a':
+			b.WriteString(`\a`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\v':
+			b.WriteString(`\v`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			if c < ' ' || c >= 0x7f {
+				b.WriteString(`\` + strconv.FormatInt(int64(c)|01000, 8)[1:])
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// ParseUnibiDump parses an entry in the dump form written by WriteUnibiDump.
+// Capabilities may be named by their long or short names or both, as in
+// "columns(cols)". Capabilities with a false boolean value are absent.
+func ParseUnibiDump(r io.Reader) (*Terminfo, error) {
+	ti := &Terminfo{
+		ExtBools:   make(map[string]bool),
+		ExtNumbers: make(map[string]int16),
+		ExtStrings: make(map[string]string),
+	}
+	var name string
+	var aliases []string
+	section := ""
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if line[0] != ' ' && line[0] != '\t' {
+			i := strings.IndexByte(line, ':')
+			if i == -1 {
+				return nil, ErrBadSource
+			}
+			section = line[:i]
+			v := strings.TrimSpace(line[i+1:])
+			switch section {
+			case "Name":
+				name = v
+			case "Aliases":
+				if v != "" {
+					aliases = strings.Split(v, ", ")
+				}
+			}
+			continue
+		}
+		i := strings.Index(line, ": ")
+		if i == -1 {
+			return nil, ErrBadSource
+		}
+		k, v := strings.TrimSpace(line[:i]), line[i+2:]
+		if j := strings.IndexByte(k, '('); j != -1 && strings.HasSuffix(k, ")") {
+			// Prefer the short name.
+			k = k[j+1 : len(k)-1]
+		}
+		if err := ti.setUnibi(section, k, v); err != nil {
+			return nil, err
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	ti.Names = append(aliases, name)
+	return ti, nil
+}
+
+// setUnibi sets the capability k of the dump section to the value v.
+func (ti *Terminfo) setUnibi(section, k, v string) error {
+	ext := strings.HasPrefix(section, "Extended ")
+	switch strings.TrimPrefix(section, "Extended ") {
+	case "Booleans", "booleans":
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return ErrBadSource
+		}
+		if ext {
+			if b {
+				ti.ExtBools[k] = true
+			}
+		} else if i, ok := caps.LookupBool(k); ok {
+			ti.Bools[i] = b
+		} else {
+			return ErrBadCapName
+		}
+	case "Numerics", "numerics":
+		n, err := strconv.ParseInt(v, 0, 16)
+		if err != nil {
+			return ErrBadSource
+		}
+		if ext {
+			ti.ExtNumbers[k] = int16(n)
+		} else if i, ok := caps.LookupNumber(k); ok {
+			ti.Numbers[i] = int16(n)
+		} else {
+			return ErrBadCapName
+		}
+	case "Strings", "strings":
+		str, err := strconv.Unquote(v)
+		if err != nil {
+			return ErrBadSource
+		}
+		if ext {
+			ti.ExtStrings[k] = str
+		} else if i, ok := caps.LookupString(k); ok {
+			ti.Strings[i] = str
+		} else {
+			return ErrBadCapName
+		}
+	default:
+		return ErrBadSource
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package terminfo
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestUnibiDump(t *testing.T) {
+	ti, err := Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err = ti.WriteUnibiDump(&b); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), "    cursor_address(cup): \"\\033[%i%p1%d;%p2%dH\"\n") {
+		t.Errorf("unexpected dump:\n%s", b.String())
+	}
+	nti, err := ParseUnibiDump(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Origins are not part of the dump.
+	nti.Origins = ti.Origins
+	if !reflect.DeepEqual(nti, ti) {
+		t.Error("round trip mismatch")
+	}
+}
+
+func TestParseUnibiDump(t *testing.T) {
+	const dump = `Name: test terminal
+Aliases: test
+Booleans:
+    am: true
+    bce: false
+Numerics:
+    columns: 80
+Strings:
+    bell(bel): "\a"
+Extended booleans:
+Extended numerics:
+Extended strings:
+    Ss: "\033[%p1%d q"
+`
+	ti, err := ParseUnibiDump(strings.NewReader(dump))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ti.Names, []string{"test", "test terminal"}) {
+		t.Errorf("Names = %q", ti.Names)
+	}
+	if !ti.Bools[caps.AutoRightMargin] || ti.Bools[caps.BackColorErase] || ti.Numbers[caps.Columns] != 80 ||
+		ti.Strings[caps.Bell] != "\a" || ti.ExtStrings["Ss"] != "\x1b[%p1%d q" {
+		t.Errorf("unexpected entry %+v", ti)
+	}
+}
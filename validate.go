@@ -0,0 +1,182 @@
+package terminfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Severity is how serious a Problem is.
+type Severity int
+
+// These are the severities.
+const (
+	// SeverityWarning is for entries that work but are likely mistaken.
+	SeverityWarning Severity = iota
+	// SeverityError is for capabilities that cannot be used as intended.
+	SeverityError
+)
+
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// MarshalText encodes the severity as its name.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Problem is an inconsistency in an entry found by Validate.
+type Problem struct {
+	Severity Severity
+	// Cap is the short name of the capability concerned, or empty if the
+	// problem concerns the whole entry.
+	Cap     string
+	Message string
+	// Err is the underlying error, such as a *ParmError, if any.
+	Err error
+	// Suggestion is how the problem may be fixed, if known.
+	Suggestion string
+}
+
+// MarshalJSON encodes the problem as an object with the fields in lower
+// case and the error as a string.
+func (p Problem) MarshalJSON() ([]byte, error) {
+	v := struct {
+		Severity   Severity `json:"severity"`
+		Cap        string   `json:"cap,omitempty"`
+		Message    string   `json:"message"`
+		Err        string   `json:"error,omitempty"`
+		Suggestion string   `json:"suggestion,omitempty"`
+	}{p.Severity, p.Cap, p.Message, "", p.Suggestion}
+	if p.Err != nil {
+		v.Err = p.Err.Error()
+	}
+	return json.Marshal(v)
+}
+
+// WriteProblems writes the problems to w, one per line, each prefixed with
+// its severity and followed by its suggestion.
+func WriteProblems(w io.Writer, ps []Problem) error {
+	for _, p := range ps {
+		s := p.Severity.String() + ": " + p.String()
+		if p.Suggestion != "" {
+			s += " (" + p.Suggestion + ")"
+		}
+		if _, err := fmt.Fprintln(w, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteProblemsJSON writes the problems to w as a JSON array.
+func WriteProblemsJSON(w io.Writer, ps []Problem) error {
+	if ps == nil {
+		ps = []Problem{}
+	}
+	return json.NewEncoder(w).Encode(ps)
+}
+
+func (p Problem) String() string {
+	s := p.Message
+	if p.Err != nil {
+		s += ": " + p.Err.Error()
+	}
+	if p.Cap != "" {
+		s = p.Cap + ": " + s
+	}
+	return s
+}
+
+// Validate checks the entry for internal consistency and returns the
+// problems found, in a stable order: parameterized strings that fail to
+// parse, malformed delays, contradicting padding capabilities, extended
+// capabilities shadowing standard ones and capabilities that are useless
+// without others.
+func Validate(ti *Terminfo) []Problem {
+	var ps []Problem
+	add := func(sev Severity, name, msg string, err error, suggestion string) {
+		ps = append(ps, Problem{Severity: sev, Cap: name, Message: msg, Err: err, Suggestion: suggestion})
+	}
+	if ti.Bools[caps.AutoRightMargin] && ti.Strings[caps.CursorAddress] == "" {
+		add(SeverityWarning, "am", "automatic margins without cursor_address", nil, "add cup or remove am")
+	}
+	if ti.Numbers[caps.MaxColors] > 0 && ti.Strings[caps.SetAForeground] == "" && ti.Strings[caps.SetForeground] == "" {
+		add(SeverityError, "colors", "colors without set_a_foreground or set_foreground", nil, "add setaf or remove colors")
+	}
+	if ti.Numbers[caps.MaxPairs] > 0 && ti.Numbers[caps.MaxColors] <= 0 {
+		add(SeverityWarning, "pairs", "color pairs without max_colors", nil, "add colors or remove pairs")
+	}
+	switch pad := ti.Strings[caps.PadChar]; {
+	case pad != "" && ti.Bools[caps.NoPadChar]:
+		add(SeverityError, "pad", "pad character along with no_pad_char", nil, "remove pad or npc")
+	case len(pad) > 1:
+		add(SeverityWarning, "pad", "only the first byte of the pad character is used", nil, "use a single character")
+	}
+	check := func(name, s string) {
+		if err := checkParm(s, EvalOptions{}); err != nil {
+			add(SeverityError, name, "bad parameterized string", err, "")
+		}
+		if !checkDelays(s) {
+			add(SeverityError, name, "malformed delay", nil, "write delays as $<n> with an optional * or /")
+		}
+	}
+	for i, s := range ti.Strings {
+		// The user strings are not parameterized with tparm.
+		if s == "" || i >= caps.User0 && i <= caps.User9 {
+			continue
+		}
+		check(caps.StringNames[i], s)
+	}
+	for _, name := range sortedKeys(ti.ExtStrings) {
+		check(name, ti.ExtStrings[name])
+	}
+	var ext []string
+	ext = append(ext, sortedKeys(ti.ExtBools)...)
+	ext = append(ext, sortedKeys(ti.ExtNumbers)...)
+	ext = append(ext, sortedKeys(ti.ExtStrings)...)
+	for _, name := range ext {
+		if standardName(name) {
+			add(SeverityError, name, "extended capability shadows a standard one", nil, "rename the extended capability")
+		}
+	}
+	return ps
+}
+
+// checkDelays reports whether the delays in s are well-formed.
+func checkDelays(s string) bool {
+	for {
+		i := strings.Index(s, "$<")
+		if i == -1 {
+			return true
+		}
+		s = s[i+2:]
+		// Like Puts, only digits and dots start a delay.
+		if s == "" || s[0] != '.' && (s[0] < '0' || s[0] > '9') {
+			continue
+		}
+		end := strings.IndexByte(s, '>')
+		if end == -1 || strings.TrimLeft(s[:end], "0123456789.*/") != "" {
+			return false
+		}
+		s = s[end+1:]
+	}
+}
+
+// standardName reports whether name is the short name of a standard
+// capability of any type.
+func standardName(name string) bool {
+	for _, lookup := range []func(string) (int, bool){caps.LookupBool, caps.LookupNumber, caps.LookupString} {
+		if _, ok := lookup(name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package terminfo
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestValidate(t *testing.T) {
+	for _, name := range []string{"xterm", "vt100", "linux"} {
+		ti, err := Load(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ps := Validate(ti); len(ps) > 0 {
+			t.Errorf("%s: unexpected problems %v", name, ps)
+		}
+	}
+
+	ti := &Terminfo{
+		ExtBools:   map[string]bool{"cup": true},
+		ExtStrings: map[string]string{"Ss": "\x1b[%p1%d q$<5"},
+	}
+	ti.Bools[caps.AutoRightMargin] = true
+	ti.Bools[caps.NoPadChar] = true
+	ti.Numbers[caps.MaxPairs] = 64
+	ti.Strings[caps.PadChar] = "\x00"
+	ti.Strings[caps.ClearScreen] = "\x1b[H\x1b[J$<50>"
+	ti.Strings[caps.SetAForeground] = "\x1b[3%p1%dm%;"
+	var got []string
+	for _, p := range Validate(ti) {
+		got = append(got, p.String())
+	}
+	want := []string{
+		"am: automatic margins without cursor_address",
+		"pairs: color pairs without max_colors",
+		"pad: pad character along with no_pad_char",
+		"setaf: bad parameterized string: terminfo: unbalanced conditional at offset 9",
+		"Ss: malformed delay",
+		"cup: extended capability shadows a standard one",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q\nwant %q", got, want)
+	}
+}
+
+func TestWriteProblems(t *testing.T) {
+	ti := &Terminfo{}
+	ti.Bools[caps.AutoRightMargin] = true
+	ti.Strings[caps.SetAForeground] = "\x1b[3%p1%dm%;"
+	ps := Validate(ti)
+	var b bytes.Buffer
+	if err := WriteProblems(&b, ps); err != nil {
+		t.Fatal(err)
+	}
+	want := "warning: am: automatic margins without cursor_address (add cup or remove am)\n" +
+		"error: setaf: bad parameterized string: terminfo: unbalanced conditional at offset 9\n"
+	if b.String() != want {
+		t.Errorf("got %q\nwant %q", b.String(), want)
+	}
+	b.Reset()
+	if err := WriteProblemsJSON(&b, ps[1:]); err != nil {
+		t.Fatal(err)
+	}
+	want = `[{"severity":"error","cap":"setaf","message":"bad parameterized string","error":"terminfo: unbalanced conditional at offset 9"}]` + "\n"
+	if b.String() != want {
+		t.Errorf("got %s\nwant %s", b.String(), want)
+	}
+	b.Reset()
+	WriteProblemsJSON(&b, nil)
+	if b.String() != "[]\n" {
+		t.Errorf("no problems encoded as %q", b.String())
+	}
+}
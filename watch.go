@@ -0,0 +1,163 @@
+package terminfo
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Returned by Watch for entries that were not read from a compiled file.
+var ErrNotFile = errors.New("terminfo: entry was not read from a file")
+
+// WatchInterval is how often the files watched by Watch are checked for
+// changes when the operating system cannot notify them.
+var WatchInterval = 2 * time.Second
+
+// Watch calls Watch on the Loader used by Load.
+func Watch(name string) (<-chan *Terminfo, func(), error) {
+	return defaultLoader.Watch(name)
+}
+
+// Watch loads the entry with the name and watches the files it was read
+// from. On Linux, the directories of the files are watched with inotify.
+// Elsewhere, and for Loaders reading from an fs.FS, the files are polled
+// every WatchInterval instead. When one of them changes,
+// the entries read from it are removed from the cache of the Loader and the
+// entry is loaded again and sent on the returned channel. If the receiver
+// falls behind, only the latest entry is kept. Calling the returned
+// function stops watching and closes the channel.
+func (l *Loader) Watch(name string) (<-chan *Terminfo, func(), error) {
+	ti, err := l.Load(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	w := &watcher{l: l, name: name, done: make(chan struct{}), changes: make(chan struct{}, 1)}
+	if !w.reset(ti) {
+		return nil, nil, ErrNotFile
+	}
+	ch := make(chan *Terminfo, 1)
+	go w.run(ch)
+	var once sync.Once
+	return ch, func() { once.Do(func() { close(w.done) }) }, nil
+}
+
+// fileState is what is compared to tell that a file changed.
+type fileState struct {
+	size    int64
+	modTime int64
+}
+
+// watcher watches the files of an entry.
+type watcher struct {
+	l       *Loader
+	name    string
+	files   map[string]fileState
+	done    chan struct{}
+	changes chan struct{} // receives the changes notified by the system
+	dirs    string        // the directories being notified, joined
+	stop    func()        // stops the notifications
+	ticker  *time.Ticker  // polls the files if the system does not notify
+}
+
+// reset watches the files ti was read from, reporting whether there are any.
+func (w *watcher) reset(ti *Terminfo) bool {
+	w.files = make(map[string]fileState)
+	for _, o := range ti.Origins {
+		if o.Kind == "file" {
+			w.files[o.Name] = w.stat(o.Name)
+		}
+	}
+	if len(w.files) == 0 {
+		return false
+	}
+	w.notify()
+	return true
+}
+
+// notify asks the system to notify changes in the directories of the files,
+// falling back to polling.
+func (w *watcher) notify() {
+	var dirs []string
+	for file := range w.files {
+		dirs = append(dirs, filepath.Dir(file))
+	}
+	sort.Strings(dirs)
+	key := strings.Join(dirs, "\x00")
+	if w.ticker != nil || key == w.dirs {
+		return
+	}
+	if w.stop != nil {
+		w.stop()
+	}
+	w.dirs, w.stop = key, nil
+	if w.l.fsys == nil {
+		if stop, ok := notifyDirs(dirs, w.changes); ok {
+			w.stop = stop
+			return
+		}
+	}
+	w.ticker = time.NewTicker(WatchInterval)
+}
+
+// close stops the notifications or the polling.
+func (w *watcher) close() {
+	if w.stop != nil {
+		w.stop()
+	}
+	if w.ticker != nil {
+		w.ticker.Stop()
+	}
+}
+
+// stat returns the state of the file, which is zero if it does not exist.
+func (w *watcher) stat(file string) fileState {
+	fi, err := w.l.stat(file)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{fi.Size(), fi.ModTime().UnixNano()}
+}
+
+// run checks the files whenever they may have changed until done is closed,
+// sending the reloaded entry on ch.
+func (w *watcher) run(ch chan *Terminfo) {
+	defer close(ch)
+	defer w.close()
+	for {
+		var tick <-chan time.Time
+		if w.ticker != nil {
+			tick = w.ticker.C
+		}
+		select {
+		case <-w.done:
+			return
+		case <-tick:
+		case <-w.changes:
+		}
+		changed := false
+		for file, st := range w.files {
+			if w.stat(file) != st {
+				w.l.cache.deleteFile(file)
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		w.l.cache.Delete(w.name)
+		ti, err := w.l.Load(w.name)
+		if err != nil {
+			// The file may be partially written; try again later.
+			continue
+		}
+		w.reset(ti)
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- ti
+	}
+}
@@ -0,0 +1,42 @@
+package terminfo
+
+import (
+	"os"
+	"syscall"
+)
+
+// inotifyMask are the events that may change a file in a watched directory.
+const inotifyMask = syscall.IN_CLOSE_WRITE | syscall.IN_ATTRIB | syscall.IN_CREATE |
+	syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO
+
+// notifyDirs sends on changes, without blocking, whenever inotify reports
+// an event that may change a file in one of the directories, until stop is
+// called. ok is false if the directories cannot be watched.
+func notifyDirs(dirs []string, changes chan<- struct{}) (stop func(), ok bool) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, false
+	}
+	for _, dir := range dirs {
+		if _, err := syscall.InotifyAddWatch(fd, dir, inotifyMask); err != nil {
+			syscall.Close(fd)
+			return nil, false
+		}
+	}
+	// As the descriptor is non-blocking, reads wait in the runtime poller
+	// and are interrupted by Close.
+	f := os.NewFile(uintptr(fd), "inotify")
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			if _, err := f.Read(buf); err != nil {
+				return
+			}
+			select {
+			case changes <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return func() { f.Close() }, true
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package terminfo
+
+// notifyDirs is not supported, so the files are polled.
+func notifyDirs(dirs []string, changes chan<- struct{}) (stop func(), ok bool) {
+	return nil, false
+}
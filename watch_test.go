@@ -0,0 +1,67 @@
+package terminfo
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestWatch(t *testing.T) {
+	defer func(d time.Duration) { WatchInterval = d }(WatchInterval)
+	WatchInterval = time.Millisecond
+	testWatch(t, func(dir string) *Loader { return NewLoader(os.DirFS(dir)) })
+}
+
+func TestWatchNotify(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("files are polled")
+	}
+	defer func(d time.Duration) { WatchInterval = d }(WatchInterval)
+	// Changes must be notified by inotify.
+	WatchInterval = time.Hour
+	testWatch(t, func(dir string) *Loader {
+		return &Loader{dirs: []string{dir}, cache: NewCache(0)}
+	})
+}
+
+func testWatch(t *testing.T, newLoader func(dir string) *Loader) {
+	dir, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := CompileTo(dir, strings.NewReader("test-term|test alias,\n\tcols#80,\n")); err != nil {
+		t.Fatal(err)
+	}
+	l := newLoader(dir)
+	ch, stop, err := l.Watch("test-term")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := CompileTo(dir, strings.NewReader("test-term|test alias,\n\tcols#132, lines#24,\n")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ti := <-ch:
+		if ti.Numbers[caps.Columns] != 132 {
+			t.Errorf("cols#%d after the update", ti.Numbers[caps.Columns])
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("update was not delivered")
+	}
+	if ti, ok := l.Cache().Get("test-term"); !ok || ti.Numbers[caps.Columns] != 132 {
+		t.Error("cache was not updated")
+	}
+	stop()
+	stop()
+	for range ch {
+	}
+	if _, _, err := l.Watch("missing"); err == nil {
+		t.Error("watched a missing entry")
+	}
+}
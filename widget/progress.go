@@ -0,0 +1,57 @@
+package widget
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nhooyr/terminfo"
+)
+
+// ProgressBar shows the progress of a task towards a total as a bar
+// spanning the width of the terminal.
+type ProgressBar struct {
+	line  *StatusLine
+	total int
+}
+
+// NewProgressBar returns a ProgressBar for total units of work that writes
+// to w using the capabilities in ti.
+func NewProgressBar(w io.Writer, ti *terminfo.Terminfo, total int) *ProgressBar {
+	return &ProgressBar{line: NewStatusLine(w, ti), total: total}
+}
+
+// Set updates the bar to show n completed units, clamped to the range from
+// 0 to the total. On terminals that cannot redraw the line in place, the
+// percentage is printed in steps of 10%.
+func (p *ProgressBar) Set(n int) error {
+	if n > p.total {
+		n = p.total
+	}
+	if n < 0 {
+		n = 0
+	}
+	pct := 100
+	if p.total > 0 {
+		pct = n * 100 / p.total
+	}
+	if !p.line.inPlace() {
+		return p.line.Set(strconv.Itoa(pct/10*10) + "%")
+	}
+	label := " " + strconv.Itoa(pct) + "%"
+	// Leave the last column empty to avoid wrapping on automargin terminals.
+	width := p.line.width() - len(label) - 3
+	if width < 1 {
+		return p.line.Set(label[1:])
+	}
+	filled := width * pct / 100
+	return p.line.Set("[" + strings.Repeat("=", filled) + strings.Repeat(" ", width-filled) + "]" + label)
+}
+
+// Done completes the bar and moves to the next line.
+func (p *ProgressBar) Done() error {
+	if err := p.Set(p.total); err != nil {
+		return err
+	}
+	return p.line.Done()
+}
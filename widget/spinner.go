@@ -0,0 +1,52 @@
+package widget
+
+import (
+	"io"
+
+	"github.com/nhooyr/terminfo"
+)
+
+// DefaultFrames are the frames used by a Spinner.
+var DefaultFrames = []string{"|", "/", "-", "\\"}
+
+// Spinner shows a message next to an animation that advances on every Tick.
+type Spinner struct {
+	line *StatusLine
+	// Frames are the frames of the animation, DefaultFrames if empty.
+	Frames []string
+	msg    string
+	frame  int
+}
+
+// NewSpinner returns a Spinner displaying msg that writes to w using the
+// capabilities in ti.
+func NewSpinner(w io.Writer, ti *terminfo.Terminfo, msg string) *Spinner {
+	return &Spinner{
+		line:   NewStatusLine(w, ti),
+		Frames: DefaultFrames,
+		msg:    msg,
+	}
+}
+
+// Tick advances the animation. On terminals that cannot redraw the line in
+// place, only the message is printed once.
+func (s *Spinner) Tick() error {
+	if !s.line.inPlace() {
+		return s.line.Set(s.msg)
+	}
+	frames := s.Frames
+	if len(frames) == 0 {
+		frames = DefaultFrames
+	}
+	f := frames[s.frame%len(frames)]
+	s.frame++
+	return s.line.Set(f + " " + s.msg)
+}
+
+// Done replaces the spinner with the final message and moves to the next line.
+func (s *Spinner) Done(final string) error {
+	if err := s.line.Set(final); err != nil {
+		return err
+	}
+	return s.line.Done()
+}
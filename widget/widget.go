@@ -0,0 +1,95 @@
+// Package widget implements small progress indicators built on terminfo
+// capabilities: a transient status line, a spinner and a progress bar, as
+// well as text wrapping to the width of a terminal.
+//
+// On terminals that can return the cursor to the start of the line and clear
+// it, the widgets redraw a single line in place. On other outputs, such as
+// the terminfo.Dumb entry used for pipes, they fall back to printing a new
+// line only when the displayed text changes.
+package widget
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/nhooyr/terminfo"
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Baud is the baud rate used to compute padding.
+var Baud = 38400
+
+// StatusLine is a single line of text that is replaced on every update.
+type StatusLine struct {
+	w    io.Writer
+	ti   *terminfo.Terminfo
+	buf  bytes.Buffer
+	last string
+}
+
+// NewStatusLine returns a StatusLine that writes to w using the
+// capabilities in ti.
+func NewStatusLine(w io.Writer, ti *terminfo.Terminfo) *StatusLine {
+	return &StatusLine{w: w, ti: ti}
+}
+
+// inPlace reports whether the line can be redrawn in place.
+func (l *StatusLine) inPlace() bool {
+	return l.ti.Strings[caps.CarriageReturn] != "" && l.ti.Strings[caps.ClrEol] != ""
+}
+
+// Set replaces the text of the line.
+func (l *StatusLine) Set(text string) error {
+	if text == l.last {
+		return nil
+	}
+	l.last = text
+	l.buf.Reset()
+	if l.inPlace() {
+		l.put(caps.CarriageReturn)
+		l.put(caps.ClrEol)
+		l.buf.WriteString(text)
+	} else {
+		l.buf.WriteString(text)
+		l.buf.WriteByte('\n')
+	}
+	_, err := l.w.Write(l.buf.Bytes())
+	return err
+}
+
+// Clear removes the line from the screen. It does nothing on terminals
+// that cannot redraw the line in place.
+func (l *StatusLine) Clear() error {
+	l.last = ""
+	if !l.inPlace() {
+		return nil
+	}
+	l.buf.Reset()
+	l.put(caps.CarriageReturn)
+	l.put(caps.ClrEol)
+	_, err := l.w.Write(l.buf.Bytes())
+	return err
+}
+
+// Done leaves the current text on the screen and moves to the next line.
+func (l *StatusLine) Done() error {
+	if !l.inPlace() || l.last == "" {
+		return nil
+	}
+	l.last = ""
+	_, err := io.WriteString(l.w, "\n")
+	return err
+}
+
+// width returns the number of columns available to the line.
+func (l *StatusLine) width() int {
+	if cols := int(l.ti.Numbers[caps.Columns]); cols > 0 {
+		return cols
+	}
+	return 80
+}
+
+// put appends the string capability i to the buffer, expanding padding.
+func (l *StatusLine) put(i int) {
+	l.ti.Puts(&l.buf, l.ti.Strings[i], 1, Baud)
+}
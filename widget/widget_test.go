@@ -0,0 +1,101 @@
+package widget
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/nhooyr/terminfo"
+)
+
+func TestProgressBarDumb(t *testing.T) {
+	b := new(bytes.Buffer)
+	p := NewProgressBar(b, terminfo.Dumb, 100)
+	for i := 0; i <= 25; i++ {
+		if err := p.Set(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.Done(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "0%\n10%\n20%\n100%\n"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestProgressBarClamp(t *testing.T) {
+	ti, err := terminfo.Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	p := NewProgressBar(b, ti, 10)
+	if err := p.Set(-3); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); !strings.Contains(got, "[ ") || !strings.HasSuffix(got, "] 0%") {
+		t.Errorf("Set(-3) wrote %q", got)
+	}
+	b.Reset()
+	if err := p.Set(25); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); !strings.Contains(got, "[=") || !strings.HasSuffix(got, "=] 100%") {
+		t.Errorf("Set(25) wrote %q", got)
+	}
+}
+
+func TestSpinnerInPlace(t *testing.T) {
+	ti, err := terminfo.Load("xterm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := new(bytes.Buffer)
+	s := NewSpinner(b, ti, "working")
+	for i := 0; i < 3; i++ {
+		if err := s.Tick(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Done("done"); err != nil {
+		t.Fatal(err)
+	}
+	got := b.String()
+	if !strings.Contains(got, "\r\x1b[K/ working") || !strings.HasSuffix(got, "\r\x1b[Kdone\n") {
+		t.Errorf("unexpected output %q", got)
+	}
+
+	b.Reset()
+	s = NewSpinner(b, ti, "working")
+	s.Frames = nil
+	if err := s.Tick(); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); !strings.Contains(got, "| working") {
+		t.Errorf("without frames: unexpected output %q", got)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  string
+	}{
+		{"the quick brown fox", 10, "the quick\nbrown fox"},
+		{"  -v  verbose output here", 12, "  -v verbose\n  output\n  here"},
+		{"\x1b[1mbold\x1b[m text", 9, "\x1b[1mbold\x1b[m text"},
+		{"abcdefghij", 4, "abcd\nefgh\nij"},
+		{"one\n\ntwo three", 5, "one\n\ntwo\nthree"},
+		{"\x1b]8;;http://x\x1b\\link\x1b]8;;\x1b\\ é", 6, "\x1b]8;;http://x\x1b\\link\x1b]8;;\x1b\\ é"},
+	}
+	for _, tt := range tests {
+		if got := Wrap(tt.text, tt.width); got != tt.want {
+			t.Errorf("Wrap(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+	if got := WrapTerm(terminfo.Dumb, strings.Repeat("x", 80)); got != strings.Repeat("x", 79)+"\nx" {
+		t.Errorf("WrapTerm used the last column of an automargin terminal: %q", got)
+	}
+}
@@ -0,0 +1,173 @@
+package widget
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nhooyr/terminfo"
+	"github.com/nhooyr/terminfo/caps"
+)
+
+// Wrap wraps each line of text to at most width columns, breaking at spaces
+// and breaking words longer than a line. Continuation lines keep the
+// indentation of their line. Escape sequences, such as those produced by the
+// capabilities of an entry, and other control characters do not take up
+// columns. Every other character is counted as one column.
+func Wrap(text string, width int) string {
+	if width < 1 {
+		width = 1
+	}
+	var b strings.Builder
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		wrapLine(&b, line, width)
+	}
+	return b.String()
+}
+
+// WrapTerm wraps text like Wrap to the columns of the terminal described by
+// ti, or 80 if the entry does not say. The last column is left empty on
+// terminals that would otherwise wrap before the newline.
+func WrapTerm(ti *terminfo.Terminfo, text string) string {
+	width := (&StatusLine{ti: ti}).width()
+	if ti.Bools[caps.AutoRightMargin] && !ti.Bools[caps.EatNewlineGlitch] {
+		width--
+	}
+	return Wrap(text, width)
+}
+
+// wrapLine writes the line wrapped to width to b.
+func wrapLine(b *strings.Builder, line string, width int) {
+	rest := strings.TrimLeft(line, " ")
+	indent := line[:len(line)-len(rest)]
+	if len(indent) >= width {
+		indent = ""
+	}
+	b.WriteString(indent)
+	col := len(indent)
+	for _, w := range splitWords(rest) {
+		ww := visibleWidth(w)
+		switch {
+		case col == len(indent):
+		case col+1+ww <= width:
+			b.WriteByte(' ')
+			col++
+		default:
+			b.WriteByte('\n')
+			b.WriteString(indent)
+			col = len(indent)
+		}
+		for col+ww > width {
+			// Break the word at the end of the line.
+			head, tail := splitWidth(w, width-col)
+			b.WriteString(head)
+			b.WriteByte('\n')
+			b.WriteString(indent)
+			col = len(indent)
+			w = tail
+			ww = visibleWidth(w)
+		}
+		b.WriteString(w)
+		col += ww
+	}
+}
+
+// splitWords splits s at spaces outside of escape sequences.
+func splitWords(s string) []string {
+	var words []string
+	start := -1
+	for i := 0; i < len(s); {
+		n := escapeLen(s[i:])
+		if n == 0 && s[i] == ' ' {
+			if start >= 0 {
+				words = append(words, s[start:i])
+				start = -1
+			}
+			i++
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+		if n == 0 {
+			n = 1
+		}
+		i += n
+	}
+	if start >= 0 {
+		words = append(words, s[start:])
+	}
+	return words
+}
+
+// splitWidth splits s after width columns.
+func splitWidth(s string, width int) (head, tail string) {
+	col := 0
+	for i := 0; i < len(s); {
+		if n := escapeLen(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if r >= ' ' && r != 0x7f {
+			if col == width {
+				return s[:i], s[i:]
+			}
+			col++
+		}
+		i += n
+	}
+	return s, ""
+}
+
+// visibleWidth returns the number of columns taken by s.
+func visibleWidth(s string) int {
+	col := 0
+	for i := 0; i < len(s); {
+		if n := escapeLen(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if r >= ' ' && r != 0x7f {
+			col++
+		}
+		i += n
+	}
+	return col
+}
+
+// escapeLen returns the length of the escape sequence at the start of s, or
+// 0 if s does not start with one. Control sequences end with their final
+// byte and control strings, such as OSC, with BEL or ST. Unterminated
+// sequences extend to the end of s.
+func escapeLen(s string) int {
+	if s == "" || s[0] != '\x1b' {
+		return 0
+	}
+	if len(s) == 1 {
+		return 1
+	}
+	switch s[1] {
+	case '[':
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']', 'P', '_', '^':
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	}
+	return 2
+}
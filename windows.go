@@ -0,0 +1,65 @@
+package terminfo
+
+import (
+	"os"
+	"strings"
+)
+
+// windowsSource describes the Windows 10 console with virtual terminal
+// processing enabled, and the terminals hosting it that support more.
+const windowsSource = `vtpcon|Windows console with virtual terminal processing,
+	am, bce, xenl,
+	colors#0x100, it#8, pairs#0x10000,
+	bel=^G, blink=\E[5m, bold=\E[1m, civis=\E[?25l,
+	clear=\E[H\E[2J, cnorm=\E[?25h, cr=\r,
+	csr=\E[%i%p1%d;%p2%dr, cub=\E[%p1%dD, cub1=^H,
+	cud=\E[%p1%dB, cud1=\n, cuf=\E[%p1%dC, cuf1=\E[C,
+	cup=\E[%i%p1%d;%p2%dH, cuu=\E[%p1%dA, cuu1=\E[A,
+	dch=\E[%p1%dP, dch1=\E[P, dl=\E[%p1%dM, dl1=\E[M,
+	ech=\E[%p1%dX, ed=\E[J, el=\E[K, el1=\E[1K, home=\E[H,
+	hpa=\E[%i%p1%dG, ht=^I, ich=\E[%p1%d@, il=\E[%p1%dL,
+	il1=\E[L, ind=\n, op=\E[39;49m, rc=\E8, rev=\E[7m, ri=\EM,
+	rmcup=\E[?1049l, rmso=\E[27m, rmul=\E[24m, sc=\E7,
+	setab=\E[%?%p1%{8}%<%t4%p1%d%e%p1%{16}%<%t10%p1%{8}%-%d%e48;5;%p1%d%;m,
+	setaf=\E[%?%p1%{8}%<%t3%p1%d%e%p1%{16}%<%t9%p1%{8}%-%d%e38;5;%p1%d%;m,
+	sgr0=\E[0m, smcup=\E[?1049h, smso=\E[7m, smul=\E[4m,
+	vpa=\E[%i%p1%dd,
+	kbs=^?, kcub1=\E[D, kcud1=\E[B, kcuf1=\E[C, kcuu1=\E[A,
+	kdch1=\E[3~, kend=\E[F, kf1=\EOP, kf2=\EOQ, kf3=\EOR,
+	kf4=\EOS, kf5=\E[15~, kf6=\E[17~, kf7=\E[18~, kf8=\E[19~,
+	kf9=\E[20~, kf10=\E[21~, kf11=\E[23~, kf12=\E[24~,
+	khome=\E[H, kich1=\E[2~, knp=\E[6~, kpp=\E[5~,
+	Tc, Se=\E[0\sq, Ss=\E[%p1%d\sq,
+ms-terminal|Windows Terminal,
+	dim=\E[2m, ritm=\E[23m, sitm=\E[3m, rmxx=\E[29m,
+	smxx=\E[9m, Ms=\E]52;%p1%s;%p2%s\E\\,
+	use=vtpcon,
+conemu|ConEmu,
+	dim=\E[2m, ritm=\E[23m, sitm=\E[3m,
+	use=vtpcon,
+`
+
+// LoadWindows returns an entry describing the Windows console the program
+// runs in, since Windows has no terminfo database: Windows Terminal if
+// $WT_SESSION is set, ConEmu if $ConEmuANSI is ON, and otherwise the
+// Windows 10 console, whose virtual terminal processing must be enabled.
+// On Windows, LoadEnv falls back to it when $TERM is unset or unknown.
+func LoadWindows() (*Terminfo, error) {
+	name := "vtpcon"
+	switch {
+	case os.Getenv("WT_SESSION") != "":
+		name = "ms-terminal"
+	case os.Getenv("ConEmuANSI") == "ON":
+		name = "conemu"
+	}
+	tis, err := ParseSource(strings.NewReader(windowsSource))
+	if err != nil {
+		return nil, err
+	}
+	for _, ti := range tis {
+		if ti.Names[0] == name {
+			return ti, nil
+		}
+	}
+	return nil, ErrBadSource
+}
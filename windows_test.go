@@ -0,0 +1,27 @@
+package terminfo
+
+import (
+	"os"
+	"testing"
+
+	"github.com/nhooyr/terminfo/caps"
+)
+
+func TestLoadWindows(t *testing.T) {
+	defer os.Setenv("WT_SESSION", os.Getenv("WT_SESSION"))
+	os.Setenv("WT_SESSION", "")
+	ti, err := LoadWindows()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Names[0] != "vtpcon" || ti.Goto(4, 9) != "\x1b[5;10H" || ti.Color(9, 200) != "\x1b[91m\x1b[48;5;200m" {
+		t.Errorf("unexpected console entry %q", ti.Names)
+	}
+	os.Setenv("WT_SESSION", "1")
+	if ti, err = LoadWindows(); err != nil {
+		t.Fatal(err)
+	}
+	if ti.Names[0] != "ms-terminal" || ti.Strings[caps.EnterItalicsMode] == "" || ti.Strings[caps.CursorAddress] == "" {
+		t.Errorf("unexpected Windows Terminal entry %q", ti.Names)
+	}
+}